@@ -0,0 +1,87 @@
+package poxxy
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BatchOption configures ApplyBatch
+type BatchOption struct {
+	// Workers is the number of items applied in parallel, one at a time when it is 0 or 1
+	Workers int
+}
+
+// ApplyBatch applies each item of a bulk request to the schema built by callback, and sets *ptr to the
+// resulting values, in the order of the items. It returns the error of each item at its index, nil for the
+// valid items; an item making the schema panic (e.g. in a custom validator) gets the panic as its error.
+// The schema of each worker is built once, bound to a value reset before each item, instead of being
+// rebuilt per item. The sub-schemas of nested fields (e.g. Struct, Slice of structs) are still built per item.
+// Invalid items hold the values assigned before validation failed.
+//
+//	var users []User
+//	errs := poxxy.ApplyBatch(items, &users, func(s *poxxy.Schema, u *User) {
+//		poxxy.WithSchema(s, poxxy.Value("email", &u.Email, poxxy.WithValidators(poxxy.Required(), poxxy.Email())))
+//	}, &poxxy.BatchOption{Workers: 4})
+func ApplyBatch[T any](items []map[string]interface{}, ptr *[]T, callback func(*Schema, *T), batchOption *BatchOption, options ...SchemaOption) []error {
+	results := make([]T, len(items))
+	errs := make([]error, len(items))
+
+	// newWorker builds the schema of a worker and returns the function applying an item to it
+	newWorker := func() func(i int) {
+		var value T
+		schema := NewSchema()
+		callback(schema, &value)
+
+		return func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					errs[i] = fmt.Errorf("panic: %v", r)
+				}
+			}()
+
+			var zero T
+			value = zero
+			errs[i] = schema.Apply(items[i], options...)
+			results[i] = value
+		}
+	}
+
+	workers := 1
+	if batchOption != nil && batchOption.Workers > 1 {
+		workers = min(batchOption.Workers, len(items))
+	}
+
+	// A single worker applies the items in the calling goroutine
+	if workers <= 1 {
+		apply := newWorker()
+		for i := range items {
+			apply(i)
+		}
+
+		*ptr = results
+		return errs
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			apply := newWorker()
+			for i := range indexes {
+				apply(i)
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	*ptr = results
+	return errs
+}
@@ -0,0 +1,91 @@
+package poxxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyBatch(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type User struct {
+		Email   string
+		Age     int
+		Address Address
+	}
+
+	callback := func(s *Schema, u *User) {
+		WithSchema(s, Value("email", &u.Email, WithValidators(Required(), Email())))
+		WithSchema(s, Value("age", &u.Age, WithValidators(Min(18))))
+		WithSchema(s, Struct("address", &u.Address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("city", &a.City, WithValidators(Required())))
+		})))
+	}
+
+	items := []map[string]interface{}{
+		{"email": "john@example.com", "age": 30, "address": map[string]interface{}{"city": "Paris"}},
+		{"email": "not an email", "age": 12},
+		{"email": "jane@example.com", "age": 40},
+	}
+
+	for _, batchOption := range []*BatchOption{nil, {Workers: 2}} {
+		t.Run(fmt.Sprintf("%+v", batchOption), func(t *testing.T) {
+			var users []User
+			errs := ApplyBatch(items, &users, callback, batchOption)
+
+			require.Len(t, errs, 3)
+			assert.NoError(t, errs[0])
+			assert.EqualError(t, errs[1], "email: invalid email format; age: value must be at least 18")
+			assert.NoError(t, errs[2])
+
+			require.Len(t, users, 3)
+			assert.Equal(t, User{Email: "john@example.com", Age: 30, Address: Address{City: "Paris"}}, users[0])
+			assert.Equal(t, User{Email: "jane@example.com", Age: 40}, users[2], "values of the previous items are reset")
+		})
+	}
+
+	t.Run("parallel", func(t *testing.T) {
+		items := make([]map[string]interface{}, 100)
+		for i := range items {
+			items[i] = map[string]interface{}{"email": fmt.Sprintf("user%d@example.com", i), "age": 18 + i%2*100}
+		}
+
+		var users []User
+		errs := ApplyBatch(items, &users, callback, &BatchOption{Workers: 8})
+		for i, user := range users {
+			assert.NoError(t, errs[i])
+			assert.Equal(t, fmt.Sprintf("user%d@example.com", i), user.Email)
+			assert.Equal(t, 18+i%2*100, user.Age)
+		}
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		callback := func(s *Schema, u *User) {
+			WithSchema(s, Value("email", &u.Email, WithValidators(ValidatorFunc(func(value string, fieldName string) error {
+				if value == "boom" {
+					panic("unexpected email")
+				}
+				return nil
+			}))))
+		}
+
+		items := []map[string]interface{}{{"email": "boom"}, {"email": "john@example.com"}}
+		for _, batchOption := range []*BatchOption{nil, {Workers: 2}} {
+			var users []User
+			errs := ApplyBatch(items, &users, callback, batchOption)
+			assert.EqualError(t, errs[0], "panic: unexpected email")
+			assert.NoError(t, errs[1])
+			assert.Equal(t, "john@example.com", users[1].Email)
+		}
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		var users []User
+		assert.Empty(t, ApplyBatch(nil, &users, callback, &BatchOption{Workers: 4}))
+		assert.Empty(t, users)
+	})
+}
@@ -0,0 +1,117 @@
+// Package bench provides realistic poxxy schemas and payloads used to measure performance.
+//
+// Run the benchmarks with:
+//
+//	go test -bench . -benchmem ./bench
+//
+// Besides the usual ns/op and allocs/op, each benchmark reports the conversions performed per
+// Apply (see poxxy.Stats) so regressions in the conversion path are visible independently of the machine.
+package bench
+
+import (
+	"fmt"
+
+	"github.com/arkan/poxxy"
+)
+
+// Scenario is a schema with the payload it is benchmarked against
+type Scenario struct {
+	// Name identifies the scenario in benchmark results
+	Name string
+	// Schema creates a fresh schema and the payload to apply to it
+	Schema func() (*poxxy.Schema, map[string]interface{})
+}
+
+// Scenarios returns the benchmarked scenarios
+func Scenarios() []Scenario {
+	return []Scenario{
+		{Name: "FlatForm", Schema: FlatForm},
+		{Name: "NestedJSON", Schema: NestedJSON},
+		{Name: "LargeSlice", Schema: LargeSlice},
+	}
+}
+
+// FlatForm is a signup form as received from an HTML form: every value is a string
+func FlatForm() (*poxxy.Schema, map[string]interface{}) {
+	var (
+		name, email, country string
+		age                  int
+		newsletter           bool
+	)
+
+	schema := poxxy.NewSchema(
+		poxxy.Value("name", &name, poxxy.WithValidators(poxxy.Required(), poxxy.MinLength(2)), poxxy.WithTransformers(poxxy.TrimSpace())),
+		poxxy.Value("email", &email, poxxy.WithValidators(poxxy.Required(), poxxy.Email())),
+		poxxy.Value("age", &age, poxxy.WithValidators(poxxy.Min(18), poxxy.Max(120))),
+		poxxy.Value("country", &country, poxxy.WithValidators(poxxy.In("FR", "DE", "US"))),
+		poxxy.Value("newsletter", &newsletter),
+	)
+
+	return schema, map[string]interface{}{
+		"name":       "  John Doe ",
+		"email":      "john@example.com",
+		"age":        "42",
+		"country":    "FR",
+		"newsletter": "true",
+	}
+}
+
+// Address is the nested struct of the NestedJSON scenario
+type Address struct {
+	Street string
+	City   string
+}
+
+// Line is an order line of the NestedJSON scenario
+type Line struct {
+	SKU      string
+	Quantity int
+}
+
+// NestedJSON is an order as received from a JSON API, with a nested struct and a slice of structs
+func NestedJSON() (*poxxy.Schema, map[string]interface{}) {
+	var (
+		id      string
+		address Address
+		lines   []Line
+	)
+
+	schema := poxxy.NewSchema(
+		poxxy.Value("id", &id, poxxy.WithValidators(poxxy.Required())),
+		poxxy.Struct("address", &address, poxxy.WithSubSchema(func(s *poxxy.Schema, a *Address) {
+			poxxy.WithSchema(s, poxxy.Value("street", &a.Street, poxxy.WithValidators(poxxy.Required())))
+			poxxy.WithSchema(s, poxxy.Value("city", &a.City, poxxy.WithValidators(poxxy.Required())))
+		})),
+		poxxy.Slice("lines", &lines, poxxy.WithSubSchema(func(s *poxxy.Schema, l *Line) {
+			poxxy.WithSchema(s, poxxy.Value("sku", &l.SKU, poxxy.WithValidators(poxxy.Required())))
+			poxxy.WithSchema(s, poxxy.Value("quantity", &l.Quantity, poxxy.WithValidators(poxxy.Min(1))))
+		})),
+	)
+
+	payload := make([]interface{}, 20)
+	for i := range payload {
+		payload[i] = map[string]interface{}{"sku": fmt.Sprintf("SKU-%d", i), "quantity": float64(i + 1)}
+	}
+
+	return schema, map[string]interface{}{
+		"id":      "order-1",
+		"address": map[string]interface{}{"street": "1 rue de Rivoli", "city": "Paris"},
+		"lines":   payload,
+	}
+}
+
+// LargeSlice is a bulk import of 10k identifiers decoded from JSON
+func LargeSlice() (*poxxy.Schema, map[string]interface{}) {
+	var ids []int
+
+	schema := poxxy.NewSchema(
+		poxxy.Slice("ids", &ids, poxxy.WithValidators(poxxy.Unique())),
+	)
+
+	payload := make([]interface{}, 10000)
+	for i := range payload {
+		payload[i] = float64(i)
+	}
+
+	return schema, map[string]interface{}{"ids": payload}
+}
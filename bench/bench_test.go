@@ -0,0 +1,39 @@
+package bench
+
+import (
+	"testing"
+
+	"github.com/arkan/poxxy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarios(t *testing.T) {
+	for _, scenario := range Scenarios() {
+		t.Run(scenario.Name, func(t *testing.T) {
+			schema, data := scenario.Schema()
+			require.NoError(t, schema.Apply(data))
+		})
+	}
+}
+
+func BenchmarkApply(b *testing.B) {
+	for _, scenario := range Scenarios() {
+		b.Run(scenario.Name, func(b *testing.B) {
+			schema, data := scenario.Schema()
+			b.ReportAllocs()
+
+			before := poxxy.Stats()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := schema.Apply(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+
+			stats := poxxy.Stats().Sub(before)
+			b.ReportMetric(float64(stats.Conversions)/float64(b.N), "conversions/op")
+			b.ReportMetric(float64(stats.Applies)/float64(b.N), "applies/op")
+		})
+	}
+}
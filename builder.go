@@ -0,0 +1,235 @@
+package poxxy
+
+// FieldBuilder is the entry point of the fluent field API
+type FieldBuilder struct {
+	name string
+}
+
+// F starts the fluent declaration of a field, e.g. poxxy.F("email").String(&email).Required().Email().Trim()
+// The builders returned by the type methods implement Field and can be passed directly to NewSchema.
+func F(name string) FieldBuilder {
+	return FieldBuilder{name: name}
+}
+
+// String binds the field to a string variable
+func (b FieldBuilder) String(ptr *string) *StringBuilder {
+	return &StringBuilder{ValueField: &ValueField[string]{name: b.name, ptr: ptr}}
+}
+
+// Int binds the field to an int variable
+func (b FieldBuilder) Int(ptr *int) *NumberBuilder[int] {
+	return &NumberBuilder[int]{ValueField: &ValueField[int]{name: b.name, ptr: ptr}}
+}
+
+// Int64 binds the field to an int64 variable
+func (b FieldBuilder) Int64(ptr *int64) *NumberBuilder[int64] {
+	return &NumberBuilder[int64]{ValueField: &ValueField[int64]{name: b.name, ptr: ptr}}
+}
+
+// Float64 binds the field to a float64 variable
+func (b FieldBuilder) Float64(ptr *float64) *NumberBuilder[float64] {
+	return &NumberBuilder[float64]{ValueField: &ValueField[float64]{name: b.name, ptr: ptr}}
+}
+
+// Bool binds the field to a bool variable
+func (b FieldBuilder) Bool(ptr *bool) *ValueBuilder[bool] {
+	return &ValueBuilder[bool]{ValueField: &ValueField[bool]{name: b.name, ptr: ptr}}
+}
+
+// ValueBuilder is a fluent builder for value fields of any type
+type ValueBuilder[T any] struct {
+	*ValueField[T]
+}
+
+// Required adds the Required validator
+func (b *ValueBuilder[T]) Required() *ValueBuilder[T] {
+	b.AppendValidators([]Validator{Required()})
+	return b
+}
+
+// With adds validators
+func (b *ValueBuilder[T]) With(validators ...Validator) *ValueBuilder[T] {
+	b.AppendValidators(validators)
+	return b
+}
+
+// Transform adds transformers
+func (b *ValueBuilder[T]) Transform(transformers ...Transformer[T]) *ValueBuilder[T] {
+	for _, transformer := range transformers {
+		b.AddTransformer(transformer)
+	}
+	return b
+}
+
+// Default sets the default value
+func (b *ValueBuilder[T]) Default(defaultValue T) *ValueBuilder[T] {
+	b.SetDefaultValue(defaultValue)
+	return b
+}
+
+// Describe sets the field description
+func (b *ValueBuilder[T]) Describe(description string) *ValueBuilder[T] {
+	b.SetDescription(description)
+	return b
+}
+
+// builderNumber is the set of numeric types supported by NumberBuilder, built by Int, Int64 and Float64.
+type builderNumber interface {
+	~int | ~int64 | ~float64
+}
+
+// NumberBuilder is a fluent builder for numeric value fields
+type NumberBuilder[T builderNumber] struct {
+	*ValueField[T]
+}
+
+// Required adds the Required validator
+func (b *NumberBuilder[T]) Required() *NumberBuilder[T] {
+	b.AppendValidators([]Validator{Required()})
+	return b
+}
+
+// Min adds the Min validator
+func (b *NumberBuilder[T]) Min(min T) *NumberBuilder[T] {
+	b.AppendValidators([]Validator{Min(min)})
+	return b
+}
+
+// Max adds the Max validator
+func (b *NumberBuilder[T]) Max(max T) *NumberBuilder[T] {
+	b.AppendValidators([]Validator{Max(max)})
+	return b
+}
+
+// In adds the In validator
+func (b *NumberBuilder[T]) In(values ...T) *NumberBuilder[T] {
+	b.AppendValidators([]Validator{In(toInterfaces(values)...)})
+	return b
+}
+
+// With adds validators
+func (b *NumberBuilder[T]) With(validators ...Validator) *NumberBuilder[T] {
+	b.AppendValidators(validators)
+	return b
+}
+
+// Transform adds transformers
+func (b *NumberBuilder[T]) Transform(transformers ...Transformer[T]) *NumberBuilder[T] {
+	for _, transformer := range transformers {
+		b.AddTransformer(transformer)
+	}
+	return b
+}
+
+// Default sets the default value
+func (b *NumberBuilder[T]) Default(defaultValue T) *NumberBuilder[T] {
+	b.SetDefaultValue(defaultValue)
+	return b
+}
+
+// Describe sets the field description
+func (b *NumberBuilder[T]) Describe(description string) *NumberBuilder[T] {
+	b.SetDescription(description)
+	return b
+}
+
+// StringBuilder is a fluent builder for string value fields
+type StringBuilder struct {
+	*ValueField[string]
+}
+
+// Required adds the Required validator
+func (b *StringBuilder) Required() *StringBuilder {
+	b.AppendValidators([]Validator{Required()})
+	return b
+}
+
+// NotEmpty adds the NotEmpty validator
+func (b *StringBuilder) NotEmpty() *StringBuilder {
+	b.AppendValidators([]Validator{NotEmpty()})
+	return b
+}
+
+// Email adds the Email validator
+func (b *StringBuilder) Email() *StringBuilder {
+	b.AppendValidators([]Validator{Email()})
+	return b
+}
+
+// URL adds the URL validator
+func (b *StringBuilder) URL() *StringBuilder {
+	b.AppendValidators([]Validator{URL()})
+	return b
+}
+
+// MinLength adds the MinLength validator
+func (b *StringBuilder) MinLength(minLen int) *StringBuilder {
+	b.AppendValidators([]Validator{MinLength(minLen)})
+	return b
+}
+
+// MaxLength adds the MaxLength validator
+func (b *StringBuilder) MaxLength(maxLen int) *StringBuilder {
+	b.AppendValidators([]Validator{MaxLength(maxLen)})
+	return b
+}
+
+// In adds the In validator
+func (b *StringBuilder) In(values ...string) *StringBuilder {
+	b.AppendValidators([]Validator{In(toInterfaces(values)...)})
+	return b
+}
+
+// With adds validators
+func (b *StringBuilder) With(validators ...Validator) *StringBuilder {
+	b.AppendValidators(validators)
+	return b
+}
+
+// Trim adds the TrimSpace transformer
+func (b *StringBuilder) Trim() *StringBuilder {
+	b.AddTransformer(TrimSpace())
+	return b
+}
+
+// Lower adds the ToLower transformer
+func (b *StringBuilder) Lower() *StringBuilder {
+	b.AddTransformer(ToLower())
+	return b
+}
+
+// Upper adds the ToUpper transformer
+func (b *StringBuilder) Upper() *StringBuilder {
+	b.AddTransformer(ToUpper())
+	return b
+}
+
+// Transform adds transformers
+func (b *StringBuilder) Transform(transformers ...Transformer[string]) *StringBuilder {
+	for _, transformer := range transformers {
+		b.AddTransformer(transformer)
+	}
+	return b
+}
+
+// Default sets the default value
+func (b *StringBuilder) Default(defaultValue string) *StringBuilder {
+	b.SetDefaultValue(defaultValue)
+	return b
+}
+
+// Describe sets the field description
+func (b *StringBuilder) Describe(description string) *StringBuilder {
+	b.SetDescription(description)
+	return b
+}
+
+// toInterfaces converts a typed slice to a slice of interface{}
+func toInterfaces[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+
+	return result
+}
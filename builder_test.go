@@ -0,0 +1,57 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFluentBuilder(t *testing.T) {
+	var email, role string
+	var age int
+	var score float64
+	var active bool
+
+	newSchema := func() *Schema {
+		return NewSchema(
+			F("email").String(&email).Required().Trim().Lower().Email().Describe("User email"),
+			F("role").String(&role).Default("user").In("user", "admin"),
+			F("age").Int(&age).Required().Min(18).Max(120),
+			F("score").Float64(&score).Min(0).Max(1),
+			F("active").Bool(&active).Default(true),
+		)
+	}
+
+	t.Run("valid data", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"email": "  John@Example.COM ",
+			"age":   30,
+			"score": 0.5,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "john@example.com", email)
+		assert.Equal(t, "user", role)
+		assert.Equal(t, 30, age)
+		assert.Equal(t, 0.5, score)
+		assert.True(t, active)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"email": "invalid",
+			"role":  "root",
+			"age":   12,
+			"score": 2.0,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, "email: invalid email format; role: value root must be one of: [user admin]; age: value must be at least 18; score: value must be at most 1.000000", err.Error())
+	})
+
+	t.Run("builders are fields", func(t *testing.T) {
+		schema := newSchema()
+		infos := schema.Describe()
+		assert.Equal(t, "email", infos[0].Name)
+		assert.Equal(t, "User email", infos[0].Description)
+		assert.Equal(t, []ConstraintInfo{{Kind: "required"}, {Kind: "email"}}, infos[0].Constraints)
+	})
+}
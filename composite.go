@@ -0,0 +1,55 @@
+package poxxy
+
+// compositeField groups several fields declared at once (e.g. Pagination).
+// It is replaced by its fields when added to a schema, so each of them is assigned, validated,
+// described and reported on its own.
+type compositeField struct {
+	name        string
+	description string
+	fields      []Field
+}
+
+// Name returns the name of the group
+func (f *compositeField) Name() string {
+	return f.name
+}
+
+// Value returns nil, the values are held by the grouped fields
+func (f *compositeField) Value() interface{} {
+	return nil
+}
+
+// Description returns the description of the group
+func (f *compositeField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the description of the group
+func (f *compositeField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign does nothing, the grouped fields are assigned by the schema
+func (f *compositeField) Assign(data map[string]interface{}, schema *Schema) error {
+	return nil
+}
+
+// Validate does nothing, the grouped fields are validated by the schema
+func (f *compositeField) Validate(schema *Schema) error {
+	return nil
+}
+
+// expandFields replaces the composite fields by the fields they group
+func expandFields(fields []Field) []Field {
+	expanded := make([]Field, 0, len(fields))
+	for _, field := range fields {
+		if composite, ok := field.(*compositeField); ok {
+			expanded = append(expanded, expandFields(composite.fields)...)
+			continue
+		}
+
+		expanded = append(expanded, field)
+	}
+
+	return expanded
+}
@@ -0,0 +1,131 @@
+package poxxy
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Condition reports whether conditional validators apply, from the values assigned to the schema.
+// Conditions are evaluated during the validation pass, once every field of the schema is assigned.
+type Condition func(schema *Schema) bool
+
+// FieldEquals returns a condition holding when the value assigned to a field equals value,
+// e.g. FieldEquals("type", "company"). Pointer fields are compared by the value they point to.
+func FieldEquals(fieldName string, value interface{}) Condition {
+	return FieldIn(fieldName, value)
+}
+
+// FieldIn returns a condition holding when the value assigned to a field is one of values
+func FieldIn(fieldName string, values ...interface{}) Condition {
+	return func(schema *Schema) bool {
+		value, ok := conditionValue(schema, fieldName)
+		if !ok {
+			return false
+		}
+
+		for _, v := range values {
+			if reflect.DeepEqual(value, v) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// FieldPresent returns a condition holding when a field is present in the input data with a non-empty value
+func FieldPresent(fieldName string) Condition {
+	return func(schema *Schema) bool {
+		return isProvided(schema, fieldName)
+	}
+}
+
+// Not returns a condition holding when condition doesn't
+func Not(condition Condition) Condition {
+	return func(schema *Schema) bool {
+		return !condition(schema)
+	}
+}
+
+// conditionValue returns the value assigned to a field, dereferencing pointers
+func conditionValue(schema *Schema, fieldName string) (interface{}, bool) {
+	value, ok := schema.GetFieldValue(fieldName)
+	if !ok || value == nil {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		return v.Elem().Interface(), true
+	}
+
+	return value, true
+}
+
+// schemaValidator is implemented by validators needing the schema, e.g. to read the other fields
+type schemaValidator interface {
+	validateWithSchema(schema *Schema, value interface{}, fieldName string) error
+}
+
+// conditionalValidator applies validators only when its condition holds
+type conditionalValidator struct {
+	condition  Condition
+	validators []Validator
+	msg        string
+}
+
+// When returns a validator applying validators only when condition holds, e.g. a SIRET required
+// for companies only:
+//
+//	poxxy.Value("siret", &siret, poxxy.WithValidators(
+//		poxxy.When(poxxy.FieldEquals("type", "company"), poxxy.Required(), poxxy.SIRET()),
+//	))
+//
+// Any func(*Schema) bool can be used as a custom Condition.
+func When(condition Condition, validators ...Validator) Validator {
+	return &conditionalValidator{condition: condition, validators: validators}
+}
+
+// Validate returns an error: conditions need the schema, conditional validators must be declared on a field
+func (v *conditionalValidator) Validate(value interface{}, fieldName string) error {
+	return fmt.Errorf("conditional validation of %s requires a schema", fieldName)
+}
+
+// validateWithSchema applies the validators if the condition holds
+func (v *conditionalValidator) validateWithSchema(schema *Schema, value interface{}, fieldName string) error {
+	if !v.condition(schema) {
+		return nil
+	}
+
+	err := validateFieldValidators(v.validators, value, fieldName, schema)
+	if err != nil && v.msg != "" {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// requiredOnly returns the conditional validator restricted to its Required validators, or nil if it has none
+func (v *conditionalValidator) requiredOnly() Validator {
+	required := requiredValidators(v.validators)
+	if len(required) == 0 {
+		return nil
+	}
+
+	return &conditionalValidator{condition: v.condition, validators: required, msg: v.msg}
+}
+
+// WithMessage sets a custom error message for the failures of the validators
+func (v *conditionalValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the validators
+func (v *conditionalValidator) Describe() ConstraintInfo {
+	return ConstraintInfo{Kind: "when", Params: map[string]interface{}{"validators": describeValidators(v.validators)}}
+}
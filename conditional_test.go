@@ -0,0 +1,67 @@
+package poxxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhen(t *testing.T) {
+	var kind, siret, vat, coupon string
+	var country *string
+	var quantity int
+	schema := NewSchema(
+		Value("type", &kind, WithValidators(Required(), In("person", "company"))),
+		Value("siret", &siret, WithValidators(When(FieldEquals("type", "company"), Required(), SIRET()))),
+		Pointer("country", &country),
+		Value("vat", &vat, WithValidators(When(FieldIn("country", "FR", "DE"), Required()).WithMessage("VAT number required in the EU"))),
+		Value("quantity", &quantity, WithValidators(When(FieldPresent("coupon"), Min(2)))),
+		Value("coupon", &coupon),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"type": "person", "quantity": 1}))
+
+	err := schema.Apply(map[string]interface{}{"type": "company", "country": "FR", "quantity": 1, "coupon": "WELCOME"})
+	require.Error(t, err)
+	assert.EqualError(t, err, "siret: field is required; vat: VAT number required in the EU; quantity: value must be at least 2")
+	assert.Equal(t, CodeRequired, err.(Errors)[1].Code)
+
+	assert.EqualError(t, schema.Apply(map[string]interface{}{"type": "company", "siret": "123"}), "siret: invalid SIRET number")
+	require.NoError(t, schema.Apply(map[string]interface{}{"type": "company", "siret": "732 829 320 00074", "country": "US"}))
+
+	t.Run("custom condition", func(t *testing.T) {
+		var password, confirmation string
+		changed := Condition(func(s *Schema) bool {
+			value, _ := s.GetFieldValue("password")
+			return value != nil && value != ""
+		})
+		schema := NewSchema(
+			Value("password", &password),
+			Value("confirmation", &confirmation, WithValidators(When(Not(Not(changed)), Required()))),
+		)
+
+		require.NoError(t, schema.Apply(map[string]interface{}{}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"password": "secret"}), "confirmation: field is required")
+	})
+
+	t.Run("fields validating only Required without a value", func(t *testing.T) {
+		var kind string
+		var timeout time.Duration
+		schema := NewSchema(
+			Value("type", &kind),
+			DurationRange("timeout", &timeout, When(FieldEquals("type", "job"), Required(), Min(time.Second))),
+		)
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"type": "page"}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"type": "job"}), "timeout: field is required")
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"type": "job", "timeout": "10ms"}), "timeout: value must be at least 1s")
+	})
+
+	t.Run("describe and standalone use", func(t *testing.T) {
+		validator := When(FieldEquals("type", "company"), Required(), MinLength(14))
+		assert.Equal(t, "when(required, min_length=14)", formatConstraint(validator.(Describer).Describe()))
+		assert.EqualError(t, validator.Validate("x", "siret"), "conditional validation of siret requires a schema")
+	})
+}
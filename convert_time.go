@@ -0,0 +1,97 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// defaultTimeLayouts are the layouts of the time strings accepted by default: RFC 3339 and dates
+var defaultTimeLayouts = []string{time.RFC3339Nano, time.DateOnly}
+
+// unixMillisThreshold is the magnitude from which unix timestamps are milliseconds rather than seconds.
+// 1e11 seconds is in the year 5138, while 1e11 milliseconds is in 1973.
+const unixMillisThreshold = 1e11
+
+// parseTime converts a time string in one of layouts, or a unix timestamp in seconds or milliseconds
+// (a number or a string of digits), to a time.Time. The default layouts are used when layouts is empty.
+func parseTime(value interface{}, layouts []string) (time.Time, error) {
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
+	}
+
+	if n, ok := value.(json.Number); ok {
+		value = n.String()
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return unixTime(float64(v.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return unixTime(float64(v.Uint()))
+		case reflect.Float32, reflect.Float64:
+			if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+				return time.Time{}, fmt.Errorf("invalid unix timestamp %v", value)
+			}
+			return unixTime(v.Float())
+		}
+
+		return time.Time{}, fmt.Errorf("cannot convert %T to time.Time", value)
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, nil
+		}
+	}
+
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return unixTime(float64(n))
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected one of the layouts %q or a unix timestamp", str, layouts)
+}
+
+// unixTime returns the UTC time of a unix timestamp, in milliseconds if its magnitude is at least
+// unixMillisThreshold and in seconds otherwise. Timestamps must fit in an int64 number of milliseconds,
+// whose times are all representable by time.Time.
+func unixTime(timestamp float64) (time.Time, error) {
+	if timestamp < -(1<<63) || timestamp >= 1<<63 {
+		return time.Time{}, fmt.Errorf("unix timestamp %v is out of range", timestamp)
+	}
+
+	if math.Abs(timestamp) >= unixMillisThreshold {
+		return time.UnixMilli(int64(timestamp)).UTC(), nil
+	}
+
+	seconds, fraction := math.Modf(timestamp)
+	return time.Unix(int64(seconds), int64(fraction*1e9)).UTC(), nil
+}
+
+// TimeLayoutsOption holds the layouts of the time strings accepted by a field
+type TimeLayoutsOption struct {
+	layouts []string
+}
+
+// Apply applies the time layouts to a field
+func (o TimeLayoutsOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetTimeLayouts([]string) }); ok {
+		f.SetTimeLayouts(o.layouts)
+	} else {
+		panic(fmt.Sprintf("WithTimeLayouts doesn't support %T", field))
+	}
+}
+
+// WithTimeLayouts sets the layouts (see time.Parse) of the time strings accepted by a time.Time Value or
+// Pointer field, instead of RFC 3339 and dates (2006-01-02). Unix timestamps are still accepted.
+//
+//	poxxy.Value("born_on", &bornOn, poxxy.WithTimeLayouts("02/01/2006"))
+func WithTimeLayouts(layouts ...string) Option {
+	return TimeLayoutsOption{layouts: layouts}
+}
@@ -2,11 +2,46 @@ package poxxy
 
 import (
 	"database/sql"
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/arkan/go-convert"
 )
 
+var (
+	convertersMu sync.RWMutex
+	converters   = map[reflect.Type]interface{}{}
+)
+
+// RegisterConverter registers a function converting strings to type T (e.g. uuid.UUID, custom IDs).
+// It is used for string input values and map keys of type T, before the built-in conversions.
+// Registering a converter for an existing type replaces the previous one.
+func RegisterConverter[T any](fn func(string) (T, error)) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+
+	converters[reflect.TypeOf((*T)(nil)).Elem()] = fn
+}
+
+// lookupConverter returns the registered string converter for type T
+func lookupConverter[T any]() (func(string) (T, error), bool) {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+
+	fn, ok := converters[reflect.TypeOf((*T)(nil)).Elem()]
+	if !ok {
+		return nil, false
+	}
+
+	return fn.(func(string) (T, error)), true
+}
+
 // convertValue converts interface{} to type T
 func convertValue[T any](value interface{}) (T, error) {
 	var zero T
@@ -22,6 +57,33 @@ func convertValue[T any](value interface{}) (T, error) {
 		return zero, nil
 	}
 
+	statConversions.Add(1)
+
+	// Use the registered converter if any
+	if str, ok := value.(string); ok {
+		if fn, ok := lookupConverter[T](); ok {
+			return fn(str)
+		}
+	}
+
+	// Durations are given in Go ("1h30m") or ISO 8601 ("PT1H30M") notation, or as integer nanoseconds
+	if _, ok := any(zero).(time.Duration); ok {
+		d, err := convertDuration(value, time.Nanosecond)
+		if err != nil {
+			return zero, err
+		}
+		return any(d).(T), nil
+	}
+
+	// Times are given as RFC 3339 strings, dates or unix timestamps
+	if _, ok := any(zero).(time.Time); ok {
+		t, err := parseTime(value, nil)
+		if err != nil {
+			return zero, err
+		}
+		return any(t).(T), nil
+	}
+
 	// Handle sql.Null types (e.g. sql.NullString, sql.NullInt64)
 	if v, ok := any(&zero).(sql.Scanner); ok {
 		err := v.Scan(value)
@@ -40,3 +102,150 @@ func convertValue[T any](value interface{}) (T, error) {
 
 	return zero, nil
 }
+
+// conversionOptions holds the conversion settings of a field
+type conversionOptions struct {
+	timeLayouts  []string      // Layouts of the accepted time strings (see WithTimeLayouts)
+	durationUnit time.Duration // Unit of the integer durations (see WithDurationUnit)
+}
+
+// convertFieldValue converts value to type T like convertValue, with the conversion settings of a field
+func convertFieldValue[T any](value interface{}, opts conversionOptions) (T, error) {
+	var zero T
+
+	if str, ok := value.(string); ok && str == "" {
+		return zero, nil
+	}
+
+	switch any(zero).(type) {
+	case time.Time:
+		if _, ok := value.(string); ok && len(opts.timeLayouts) > 0 {
+			t, err := parseTime(value, opts.timeLayouts)
+			if err != nil {
+				return zero, err
+			}
+			return any(t).(T), nil
+		}
+	case time.Duration:
+		if opts.durationUnit != 0 {
+			d, err := convertDuration(value, opts.durationUnit)
+			if err != nil {
+				return zero, err
+			}
+			return any(d).(T), nil
+		}
+	}
+
+	return convertValue[T](value)
+}
+
+// convertKey converts a map key, always a string in JSON objects and forms, to type K.
+// It uses, in order: the registered converter, encoding.TextUnmarshaler (e.g. uuid.UUID),
+// strict number and boolean parsing for basic kinds, and convertValue.
+func convertKey[K any](key string) (K, error) {
+	var zero K
+
+	if v, ok := any(key).(K); ok {
+		return v, nil
+	}
+
+	if fn, ok := lookupConverter[K](); ok {
+		return fn(key)
+	}
+
+	if unmarshaler, ok := any(&zero).(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(key)); err != nil {
+			return zero, err
+		}
+		return zero, nil
+	}
+
+	target := reflect.ValueOf(&zero).Elem()
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, target.Type().Bits())
+		if err != nil {
+			return zero, fmt.Errorf("cannot convert %q to %T", key, zero)
+		}
+		target.SetInt(n)
+		return zero, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(key, 10, target.Type().Bits())
+		if err != nil {
+			return zero, fmt.Errorf("cannot convert %q to %T", key, zero)
+		}
+		target.SetUint(n)
+		return zero, nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(key, target.Type().Bits())
+		if err != nil {
+			return zero, fmt.Errorf("cannot convert %q to %T", key, zero)
+		}
+		target.SetFloat(n)
+		return zero, nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(key)
+		if err != nil {
+			return zero, fmt.Errorf("cannot convert %q to %T", key, zero)
+		}
+		target.SetBool(b)
+		return zero, nil
+	case reflect.String:
+		target.SetString(key)
+		return zero, nil
+	}
+
+	return convertValue[K](key)
+}
+
+// convertStrict converts value to type T without coercion between kinds:
+// numbers must be numbers (integral for integer types), strings must be strings and booleans must be booleans.
+// Other types are converted with convertValue.
+func convertStrict[T any](value interface{}) (T, error) {
+	var zero T
+
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	// json.Number is a number, not a string
+	if n, ok := value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			value = i
+		} else if f, err := n.Float64(); err == nil {
+			value = f
+		}
+	}
+
+	target := reflect.TypeOf((*T)(nil)).Elem()
+	source := reflect.ValueOf(value)
+
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		case reflect.Float32, reflect.Float64:
+			if source.Float() != math.Trunc(source.Float()) {
+				return zero, fmt.Errorf("expected %s, got %v", target, value)
+			}
+		default:
+			return zero, fmt.Errorf("expected %s, got %T", target, value)
+		}
+	case reflect.Float32, reflect.Float64:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			return zero, fmt.Errorf("expected %s, got %T", target, value)
+		}
+	case reflect.String, reflect.Bool:
+		if source.Kind() != target.Kind() {
+			return zero, fmt.Errorf("expected %s, got %T", target, value)
+		}
+	}
+
+	return convertValue[T](value)
+}
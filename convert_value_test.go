@@ -1,9 +1,15 @@
 package poxxy
 
 import (
+	"fmt"
+	"math"
+	"net"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConvertValue(t *testing.T) {
@@ -310,3 +316,214 @@ func TestWasAssignedMechanism_AllFieldTypes(t *testing.T) {
 		assert.Equal(t, "foo: field is required", err.Error())
 	})
 }
+
+// testUserID is a custom ID type like uuid.UUID
+type testUserID [2]byte
+
+func TestConvertKey(t *testing.T) {
+	type level int
+
+	t.Run("basic kinds", func(t *testing.T) {
+		i, err := convertKey[int]("42")
+		assert.NoError(t, err)
+		assert.Equal(t, 42, i)
+
+		l, err := convertKey[level]("3")
+		assert.NoError(t, err)
+		assert.Equal(t, level(3), l)
+
+		u, err := convertKey[uint8]("255")
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(255), u)
+
+		_, err = convertKey[uint8]("256")
+		assert.EqualError(t, err, `cannot convert "256" to uint8`)
+
+		_, err = convertKey[int]("1.5")
+		assert.Error(t, err)
+
+		b, err := convertKey[bool]("true")
+		assert.NoError(t, err)
+		assert.True(t, b)
+	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		ip, err := convertKey[net.IP]("192.168.1.1")
+		assert.NoError(t, err)
+		assert.Equal(t, "192.168.1.1", ip.String())
+	})
+
+	t.Run("registered converter", func(t *testing.T) {
+		RegisterConverter(func(s string) (testUserID, error) {
+			if len(s) != 2 {
+				return testUserID{}, fmt.Errorf("invalid user id")
+			}
+			return testUserID{s[0], s[1]}, nil
+		})
+		t.Cleanup(func() {
+			convertersMu.Lock()
+			delete(converters, reflect.TypeOf(testUserID{}))
+			convertersMu.Unlock()
+		})
+
+		id, err := convertKey[testUserID]("ab")
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID{'a', 'b'}, id)
+
+		id, err = convertValue[testUserID]("cd")
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID{'c', 'd'}, id)
+
+		var scores map[testUserID]int
+		schema := NewSchema(Map("scores", &scores))
+		err = schema.Apply(map[string]interface{}{"scores": map[string]interface{}{"ab": 1, "abc": 2}})
+		assert.EqualError(t, err, `scores: key "abc": invalid user id`)
+	})
+}
+
+func TestMapField_KeyConversion(t *testing.T) {
+	var scores map[int]float64
+	schema := NewSchema(Map("scores", &scores))
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"scores": map[string]interface{}{"1": 9.5, "2": 7.0}}))
+	assert.Equal(t, map[int]float64{1: 9.5, 2: 7.0}, scores)
+
+	err := schema.Apply(map[string]interface{}{"scores": map[string]interface{}{"one": 9.5}})
+	assert.EqualError(t, err, `scores: key "one": cannot convert "one" to int`)
+}
+
+func TestArrayField_Sources(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"decoded JSON", []interface{}{1.0, "2", 3}},
+		{"typed slice", []int{1, 2, 3}},
+		{"other slice", []string{"1", "2", "3"}},
+		{"array", [3]int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v [3]int
+			schema := NewSchema(Array[int]("v", &v))
+
+			require.NoError(t, schema.Apply(map[string]interface{}{"v": tt.input}))
+			assert.Equal(t, [3]int{1, 2, 3}, v)
+		})
+	}
+
+	t.Run("length mismatch", func(t *testing.T) {
+		var v [3]int
+		schema := NewSchema(Array[int]("v", &v))
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"v": []interface{}{1}}), "v: array length mismatch: expected 3, got 1")
+	})
+}
+
+func TestConvertValue_Duration(t *testing.T) {
+	var timeout time.Duration
+	var ttl *time.Duration
+	schema := NewSchema(
+		Value("timeout", &timeout),
+		Pointer("ttl", &ttl),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"timeout": "P1DT2H", "ttl": "1h30m"}))
+	assert.Equal(t, 26*time.Hour, timeout)
+	require.NotNil(t, ttl)
+	assert.Equal(t, 90*time.Minute, *ttl)
+
+	err := schema.Apply(map[string]interface{}{"timeout": "P1M"})
+	assert.EqualError(t, err, `timeout: invalid ISO 8601 duration "P1M": years and months are not supported`)
+
+	t.Run("integers", func(t *testing.T) {
+		var delay time.Duration
+		var timeout *time.Duration
+		newSchema := func() *Schema {
+			delay, timeout = 0, nil
+			return NewSchema(
+				Value("delay", &delay, WithValidators(Max(time.Minute))),
+				Pointer("timeout_ms", &timeout, WithDurationUnit(time.Millisecond), WithValidators(Min(time.Second))),
+			)
+		}
+
+		require.NoError(t, newSchema().Apply(map[string]interface{}{"delay": float64(1500), "timeout_ms": "2500"}))
+		assert.Equal(t, 1500*time.Nanosecond, delay)
+		require.NotNil(t, timeout)
+		assert.Equal(t, 2500*time.Millisecond, *timeout)
+
+		require.NoError(t, newSchema().Apply(map[string]interface{}{"timeout_ms": "5s"}))
+		assert.Equal(t, 5*time.Second, *timeout)
+
+		err := newSchema().Apply(map[string]interface{}{"delay": "2m", "timeout_ms": 500})
+		assert.EqualError(t, err, "delay: value must be at most 1m0s; timeout_ms: value must be at least 1s")
+
+		err = newSchema().Apply(map[string]interface{}{"delay": 1.5, "timeout_ms": 1000})
+		assert.EqualError(t, err, "delay: invalid duration 1.5, expected an integer")
+
+		err = newSchema().Apply(map[string]interface{}{"timeout_ms": float64(math.MaxInt64 / 1000)})
+		require.Error(t, err)
+		assert.EqualError(t, err.(Errors).Primary(), "timeout_ms: duration 9.223372036854776e+15 is out of range")
+
+		err = newSchema().Apply(map[string]interface{}{"delay": float64(1 << 63), "timeout_ms": 1000})
+		assert.EqualError(t, err, "delay: duration 9.223372036854776e+18 is out of range")
+
+		err = newSchema().Apply(map[string]interface{}{"delay": float64(-1 << 63)}, WithSkipValidators(true))
+		require.NoError(t, err)
+		assert.Equal(t, time.Duration(math.MinInt64), delay)
+	})
+}
+
+func TestConvertValue_Time(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected time.Time
+	}{
+		{"RFC 3339", "2024-03-15T10:30:00Z", time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{"RFC 3339 with offset", "2024-03-15T12:30:00+02:00", time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{"date only", "2024-03-15", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"unix seconds", float64(1710498600), time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{"unix seconds string", "1710498600", time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{"unix millis", int64(1710498600500), time.Date(2024, 3, 15, 10, 30, 0, 500e6, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := convertValue[time.Time](tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "got %v", result)
+		})
+	}
+
+	_, err := convertValue[time.Time]("15/03/2024")
+	assert.EqualError(t, err, `invalid time "15/03/2024", expected one of the layouts ["2006-01-02T15:04:05.999999999Z07:00" "2006-01-02"] or a unix timestamp`)
+
+	for _, timestamp := range []interface{}{1e300, -1e300, 1e19, uint64(math.MaxUint64)} {
+		_, err = convertValue[time.Time](timestamp)
+		assert.ErrorContains(t, err, "is out of range", "%v", timestamp)
+	}
+	result, err := convertValue[time.Time](int64(-1 << 62))
+	require.NoError(t, err)
+	assert.True(t, time.UnixMilli(-1<<62).Equal(result))
+
+	t.Run("fields", func(t *testing.T) {
+		var createdAt time.Time
+		var bornOn *time.Time
+		schema := NewSchema(
+			Value("created_at", &createdAt),
+			Pointer("born_on", &bornOn, WithTimeLayouts("02/01/2006")),
+		)
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"created_at": "2024-03-15", "born_on": "15/03/1990"}))
+		assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), createdAt)
+		require.NotNil(t, bornOn)
+		assert.Equal(t, time.Date(1990, 3, 15, 0, 0, 0, 0, time.UTC), *bornOn)
+
+		err := schema.Apply(map[string]interface{}{"born_on": "1990-03-15"})
+		assert.EqualError(t, err, `born_on: invalid time "1990-03-15", expected one of the layouts ["02/01/2006"] or a unix timestamp`)
+
+		assert.Panics(t, func() { Map("dates", new(map[string]time.Time), WithTimeLayouts("02/01/2006")) })
+	})
+}
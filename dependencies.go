@@ -0,0 +1,209 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DependenciesAppender is an interface for fields that can declare the fields they depend on
+type DependenciesAppender interface {
+	AppendDependencies(fieldNames []string)
+}
+
+// DependenciesGetter is an interface for fields that expose the fields they depend on
+type DependenciesGetter interface {
+	GetDependencies() []string
+}
+
+// DependenciesOption holds the names of the fields a field depends on
+type DependenciesOption struct {
+	fieldNames []string
+}
+
+// Apply applies the dependencies to the field
+func (o DependenciesOption) Apply(field interface{}) {
+	if appender, ok := field.(DependenciesAppender); ok {
+		appender.AppendDependencies(o.fieldNames)
+	} else {
+		panic(fmt.Sprintf("After doesn't support %T", field))
+	}
+}
+
+// After declares that a field must be assigned after the given fields, whatever the declaration order.
+// It is used with WithDefaultFunc so computed defaults can read the assigned value of other fields:
+//
+//	poxxy.Value("currency", &currency, poxxy.After("country"), poxxy.WithDefaultFunc(func(s *poxxy.Schema) (string, error) {
+//		country, _ := s.GetFieldValue("country")
+//		return currencyOf(country), nil
+//	})),
+//	poxxy.Value("country", &country),
+func After(fieldNames ...string) Option {
+	return DependenciesOption{fieldNames: fieldNames}
+}
+
+// computedField is implemented by fields computed after all the other fields are assigned
+type computedField interface {
+	isComputed()
+}
+
+// assignmentOrder returns the fields of the schema in assignment order:
+// declaration order, except that fields are moved after the fields they depend on
+// and computed fields come after the fields they may read (the fields not depending on a computed field)
+func (s *Schema) assignmentOrder() []Field {
+	reorder := false
+	for _, field := range s.fields {
+		if getter, ok := field.(DependenciesGetter); ok && len(getter.GetDependencies()) > 0 {
+			reorder = true
+			break
+		}
+		if _, ok := field.(computedField); ok {
+			reorder = true
+			break
+		}
+	}
+	if !reorder {
+		return s.fields
+	}
+
+	byName := make(map[string]Field, len(s.fields))
+	for _, field := range s.fields {
+		if _, exists := byName[field.Name()]; !exists && !isRule(field) {
+			byName[field.Name()] = field
+		}
+	}
+
+	// dependsOnComputed reports whether a field is assigned after a computed field,
+	// directly or through its dependencies
+	afterComputed := make(map[Field]bool, len(s.fields))
+	var dependsOnComputed func(field Field) bool
+	dependsOnComputed = func(field Field) bool {
+		if result, ok := afterComputed[field]; ok {
+			return result
+		}
+		afterComputed[field] = false // Cycles are reported by CheckDependencies
+
+		if getter, ok := field.(DependenciesGetter); ok {
+			for _, name := range getter.GetDependencies() {
+				dependency, ok := byName[name]
+				if !ok {
+					continue
+				}
+				if _, computed := dependency.(computedField); computed || dependsOnComputed(dependency) {
+					afterComputed[field] = true
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	ordered := make([]Field, 0, len(s.fields))
+	visited := make(map[Field]bool, len(s.fields))
+
+	var visit func(field Field)
+	visit = func(field Field) {
+		if visited[field] {
+			return
+		}
+		visited[field] = true
+
+		// Computed fields read the other fields: the fields not assigned after a computed field come first
+		if _, ok := field.(computedField); ok {
+			for _, other := range s.fields {
+				if _, computed := other.(computedField); !computed && !isRule(other) && !dependsOnComputed(other) {
+					visit(other)
+				}
+			}
+		}
+
+		if getter, ok := field.(DependenciesGetter); ok {
+			for _, name := range getter.GetDependencies() {
+				if dependency, ok := byName[name]; ok {
+					visit(dependency)
+				}
+			}
+		}
+
+		ordered = append(ordered, field)
+	}
+
+	for _, field := range s.fields {
+		if _, ok := field.(computedField); !ok {
+			visit(field)
+		}
+	}
+	for _, field := range s.fields {
+		visit(field)
+	}
+
+	return ordered
+}
+
+// CheckDependencies verifies the dependencies declared with After: every dependency must be a field
+// of the schema and dependencies must not form a cycle.
+// It is called by NewSchema, which panics on error; schemas built with WithSchema can call it explicitly.
+func (s *Schema) CheckDependencies() error {
+	byName := make(map[string]Field, len(s.fields))
+	for _, field := range s.fields {
+		if _, exists := byName[field.Name()]; !exists && !isRule(field) {
+			byName[field.Name()] = field
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(byName))
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			// Report the cycle from its first occurrence in the current path
+			start := 0
+			for i, item := range path {
+				if item == name {
+					start = i
+					break
+				}
+			}
+			cycle := append(append([]string{}, path[start:]...), name)
+			return fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		if getter, ok := byName[name].(DependenciesGetter); ok {
+			for _, dependency := range getter.GetDependencies() {
+				if _, exists := byName[dependency]; !exists {
+					return fmt.Errorf("field %q depends on unknown field %q", name, dependency)
+				}
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+
+	for _, field := range s.fields {
+		if isRule(field) {
+			continue
+		}
+		if err := visit(field.Name()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
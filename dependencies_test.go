@@ -0,0 +1,112 @@
+package poxxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAfter_DependentDefaults(t *testing.T) {
+	currencies := map[string]string{"FR": "EUR", "US": "USD"}
+
+	var currency, country string
+	var vat *float64
+
+	schema := NewSchema(
+		// Declared before the field it depends on
+		Value("currency", &currency, After("country"), WithDefaultFunc(func(s *Schema) (string, error) {
+			country, _ := s.GetFieldValue("country")
+			code, ok := currencies[country.(string)]
+			if !ok {
+				return "", errors.New("no default currency for this country")
+			}
+			return code, nil
+		})),
+		Pointer("vat", &vat, After("country"), WithDefaultFunc(func(s *Schema) (float64, error) {
+			if country, _ := s.GetFieldValue("country"); country == "FR" {
+				return 20, nil
+			}
+			return 0, nil
+		})),
+		Value("country", &country, WithDefault("FR")),
+	)
+
+	t.Run("computed from the input", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"country": "US"}))
+		assert.Equal(t, "USD", currency)
+		assert.Equal(t, 0.0, *vat)
+		assert.Equal(t, FromDefault, schema.Provenance("currency"))
+	})
+
+	t.Run("computed from another default", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{}))
+		assert.Equal(t, "EUR", currency)
+		assert.Equal(t, 20.0, *vat)
+	})
+
+	t.Run("provided value wins", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"country": "FR", "currency": "CHF"}))
+		assert.Equal(t, "CHF", currency)
+	})
+
+	t.Run("default function error", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{"country": "JP"})
+		assert.EqualError(t, err, "currency: no default currency for this country")
+	})
+}
+
+func TestSchema_AssignmentOrder(t *testing.T) {
+	var a, b, c, d string
+	fa := Value("a", &a, After("c"))
+	fb := Value("b", &b)
+	fc := Value("c", &c, After("d"))
+	fd := Value("d", &d)
+
+	schema := NewSchema(fa, fb, fc, fd)
+	assert.Equal(t, []Field{fd, fc, fa, fb}, schema.assignmentOrder())
+
+	t.Run("unsupported field", func(t *testing.T) {
+		var tags []string
+		assert.Panics(t, func() { Slice("tags", &tags, After("a")) })
+	})
+}
+
+func TestSchema_CheckDependencies(t *testing.T) {
+	var a, b, c string
+
+	t.Run("valid", func(t *testing.T) {
+		schema := NewSchema(Value("a", &a, After("b")), Value("b", &b))
+		assert.NoError(t, schema.CheckDependencies())
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		assert.PanicsWithError(t, "dependency cycle: a -> b -> c -> a", func() {
+			NewSchema(
+				Value("a", &a, After("b")),
+				Value("b", &b, After("c")),
+				Value("c", &c, After("a")),
+			)
+		})
+	})
+
+	t.Run("self dependency", func(t *testing.T) {
+		assert.PanicsWithError(t, "dependency cycle: a -> a", func() {
+			NewSchema(Value("a", &a, After("a")))
+		})
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		assert.PanicsWithError(t, `field "a" depends on unknown field "contry"`, func() {
+			NewSchema(Value("a", &a, After("contry")))
+		})
+	})
+
+	t.Run("schemas built with WithSchema", func(t *testing.T) {
+		schema := NewSchema()
+		WithSchema(schema, Value("a", &a, After("b")))
+		WithSchema(schema, Value("b", &b, After("a")))
+		assert.EqualError(t, schema.CheckDependencies(), "dependency cycle: a -> b -> a")
+	})
+}
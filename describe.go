@@ -0,0 +1,187 @@
+package poxxy
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ConstraintInfo describes the constraint enforced by a validator.
+// It is used to generate documentation (JSON Schema, OpenAPI, ...) from a schema.
+type ConstraintInfo struct {
+	// Kind identifies the constraint (e.g. "required", "min", "email")
+	Kind string
+	// Params holds the parameters of the constraint (e.g. {"min": 18})
+	Params map[string]interface{}
+}
+
+// Describer is an interface for validators that can describe their constraint
+type Describer interface {
+	// Describe returns the constraint metadata of the validator
+	Describe() ConstraintInfo
+}
+
+// FieldInfo describes a schema field for documentation and introspection
+type FieldInfo struct {
+	Name        string
+	Description string
+	// Type is the Go type of the bound value (e.g. "string", "[]main.Person")
+	Type        string
+	Constraints []ConstraintInfo
+	HasDefault  bool
+	Default     interface{}
+	// Fields describes the sub-schema of struct, pointer, slice and HTTP map fields
+	Fields []FieldInfo
+}
+
+// fieldDescriber is implemented by built-in fields to complete their FieldInfo
+type fieldDescriber interface {
+	describeField(info *FieldInfo)
+}
+
+// Describe returns the description of every field of the schema, in declaration order
+func (s *Schema) Describe() []FieldInfo {
+	infos := make([]FieldInfo, 0, len(s.fields))
+	for _, field := range s.fields {
+		if isRule(field) {
+			continue
+		}
+
+		info := FieldInfo{
+			Name:        field.Name(),
+			Description: field.Description(),
+		}
+
+		if getter, ok := field.(ValidatorsGetter); ok {
+			info.Constraints = describeValidators(getter.GetValidators())
+		}
+
+		if describer, ok := field.(fieldDescriber); ok {
+			describer.describeField(&info)
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// describeValidators returns the constraint metadata of the validators that can describe themselves.
+// Custom validators without metadata are skipped.
+func describeValidators(validators []Validator) []ConstraintInfo {
+	var infos []ConstraintInfo
+	for _, validator := range validators {
+		describer, ok := validator.(Describer)
+		if !ok {
+			continue
+		}
+
+		info := describer.Describe()
+		if info.Kind == "" {
+			continue
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// String returns a human-readable representation of the schema field tree
+func (s *Schema) String() string {
+	var sb strings.Builder
+	_ = s.Dump(&sb)
+	return sb.String()
+}
+
+// Dump writes a human-readable representation of the schema field tree to w.
+// Each field is printed on its own line with its type, constraints, default value and description,
+// sub-schema fields being indented below their parent.
+func (s *Schema) Dump(w io.Writer) error {
+	return dumpFields(w, s.Describe(), 0)
+}
+
+// dumpFields writes the given fields at the given depth
+func dumpFields(w io.Writer, infos []FieldInfo, depth int) error {
+	for _, info := range infos {
+		line := strings.Repeat("  ", depth) + info.Name
+		if info.Type != "" {
+			line += " " + info.Type
+		}
+		if len(info.Constraints) > 0 {
+			line += " [" + formatConstraints(info.Constraints) + "]"
+		}
+		if info.HasDefault {
+			line += " default=" + formatParam(info.Default)
+		}
+		if info.Description != "" {
+			line += " - " + info.Description
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+
+		if err := dumpFields(w, info.Fields, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatConstraints formats constraints like "required, min=18, each(email)"
+func formatConstraints(infos []ConstraintInfo) string {
+	parts := make([]string, 0, len(infos))
+	for _, info := range infos {
+		parts = append(parts, formatConstraint(info))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// formatConstraint formats a single constraint
+func formatConstraint(info ConstraintInfo) string {
+	if len(info.Params) == 0 {
+		return info.Kind
+	}
+
+	// Nested validators (e.g. Each)
+	if nested, ok := info.Params["validators"].([]ConstraintInfo); ok && len(info.Params) == 1 {
+		return info.Kind + "(" + formatConstraints(nested) + ")"
+	}
+
+	// Single parameter named after the constraint (e.g. min=18)
+	if value, ok := info.Params[info.Kind]; ok && len(info.Params) == 1 {
+		return info.Kind + "=" + formatParam(value)
+	}
+
+	keys := make([]string, 0, len(info.Params))
+	for key := range info.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, key+"="+formatParam(info.Params[key]))
+	}
+
+	return info.Kind + "(" + strings.Join(params, ", ") + ")"
+}
+
+// formatParam formats a constraint parameter or default value
+func formatParam(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return fmt.Sprintf("%q", str)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// typeName returns the name of type T
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
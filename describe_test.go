@@ -0,0 +1,119 @@
+package poxxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorDescribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		expected  ConstraintInfo
+	}{
+		{"required", Required(), ConstraintInfo{Kind: "required"}},
+		{"email", Email(), ConstraintInfo{Kind: "email"}},
+		{"min", Min(18), ConstraintInfo{Kind: "min", Params: map[string]interface{}{"min": 18}}},
+		{"max", Max(120), ConstraintInfo{Kind: "max", Params: map[string]interface{}{"max": 120}}},
+		{"min length", MinLength(3), ConstraintInfo{Kind: "min_length", Params: map[string]interface{}{"min_length": 3}}},
+		{"in", In("a", "b"), ConstraintInfo{Kind: "in", Params: map[string]interface{}{"values": []interface{}{"a", "b"}}}},
+		{"with message", Min(18).WithMessage("too young"), ConstraintInfo{Kind: "min", Params: map[string]interface{}{"min": 18}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			describer, ok := tt.validator.(Describer)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, describer.Describe())
+		})
+	}
+
+	t.Run("each describes nested validators", func(t *testing.T) {
+		info := Each(Email(), MaxLength(50)).(Describer).Describe()
+		assert.Equal(t, "each", info.Kind)
+		assert.Equal(t, []ConstraintInfo{
+			{Kind: "email"},
+			{Kind: "max_length", Params: map[string]interface{}{"max_length": 50}},
+		}, info.Params["validators"])
+	})
+}
+
+func TestSchema_Describe(t *testing.T) {
+	var name string
+	var age int
+	var tags []string
+
+	schema := NewSchema(
+		Value("name", &name, WithDescription("User name"), WithValidators(Required(), MinLength(2))),
+		Value("age", &age, WithValidators(Min(18), ValidatorFunc(func(v int, fieldName string) error { return nil }))),
+		Slice("tags", &tags),
+	)
+
+	infos := schema.Describe()
+	assert.Equal(t, []FieldInfo{
+		{
+			Name:        "name",
+			Description: "User name",
+			Type:        "string",
+			Constraints: []ConstraintInfo{
+				{Kind: "required"},
+				{Kind: "min_length", Params: map[string]interface{}{"min_length": 2}},
+			},
+		},
+		{
+			Name:        "age",
+			Type:        "int",
+			Constraints: []ConstraintInfo{{Kind: "min", Params: map[string]interface{}{"min": 18}}},
+		},
+		{
+			Name: "tags",
+			Type: "[]string",
+		},
+	}, infos)
+}
+
+func TestSchema_Dump(t *testing.T) {
+	type Address struct {
+		Street string
+		City   string
+	}
+	type User struct {
+		Name    string
+		Role    string
+		Address Address
+		Tags    []string
+	}
+
+	var user User
+	var page int
+	schema := NewSchema(
+		Struct("user", &user, WithDescription("The user"), WithSubSchema(func(s *Schema, u *User) {
+			WithSchema(s, Value("name", &u.Name, WithValidators(Required(), MinLength(2))))
+			WithSchema(s, Value("role", &u.Role, WithDefault("member"), WithValidators(In("member", "admin"))))
+			WithSchema(s, Struct("address", &u.Address, WithSubSchema(func(s *Schema, a *Address) {
+				WithSchema(s, Value("city", &a.City, WithValidators(Required())))
+			})))
+			WithSchema(s, Slice("tags", &u.Tags, WithValidators(Each(MaxLength(10)), Unique())))
+		})),
+		Value("page", &page, WithDefault(1), WithValidators(Min(1))),
+	)
+
+	expected := `user poxxy.User - The user
+  name string [required, min_length=2]
+  role string [in(values=[member admin])] default="member"
+  address poxxy.Address
+    city string [required]
+  tags []string [each(max_length=10), unique]
+page int [min=1] default=1
+`
+	assert.Equal(t, expected, schema.String())
+
+	var sb strings.Builder
+	assert.NoError(t, schema.Dump(&sb))
+	assert.Equal(t, expected, sb.String())
+
+	// Describing the schema must not mutate the bound variables
+	assert.Equal(t, User{}, user)
+}
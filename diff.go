@@ -0,0 +1,339 @@
+package poxxy
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ChangeKind identifies the kind of a schema change reported by Diff
+type ChangeKind string
+
+const (
+	// FieldAdded reports a field only present in the new schema
+	FieldAdded ChangeKind = "field_added"
+	// FieldRemoved reports a field only present in the old schema
+	FieldRemoved ChangeKind = "field_removed"
+	// TypeChanged reports a field whose type changed
+	TypeChanged ChangeKind = "type_changed"
+	// DefaultChanged reports a field whose default value was added, removed or changed
+	DefaultChanged ChangeKind = "default_changed"
+	// ConstraintTightened reports a constraint rejecting inputs the old schema accepted
+	// (e.g. a new Required, a higher Min or fewer In values)
+	ConstraintTightened ChangeKind = "constraint_tightened"
+	// ConstraintLoosened reports a constraint accepting inputs the old schema rejected
+	// (e.g. a removed Required, a higher Max or more In values)
+	ConstraintLoosened ChangeKind = "constraint_loosened"
+	// ConstraintChanged reports a constraint whose parameters changed in a way that can't be ordered
+	// (e.g. a different pattern)
+	ConstraintChanged ChangeKind = "constraint_changed"
+)
+
+// Change describes a difference between two schemas
+type Change struct {
+	// Field is the dotted path of the field (e.g. "address.zip")
+	Field string
+	Kind  ChangeKind
+	// Constraint is the kind of the constraint for constraint changes (e.g. "min")
+	Constraint string
+	// Old and New hold the old and new type, default or constraint, nil when absent
+	Old interface{}
+	New interface{}
+	// Breaking reports whether inputs accepted by the old schema may be rejected or handled differently by the new one
+	Breaking bool
+}
+
+// String returns a human-readable representation of the change, e.g. "age: constraint min tightened (min=18 -> min=21)"
+func (c Change) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.Field + ": ")
+
+	switch c.Kind {
+	case FieldAdded:
+		sb.WriteString("field added")
+	case FieldRemoved:
+		sb.WriteString("field removed")
+	case TypeChanged:
+		sb.WriteString("type changed")
+	case DefaultChanged:
+		sb.WriteString("default changed")
+	default:
+		sb.WriteString("constraint " + c.Constraint + " " + strings.TrimPrefix(string(c.Kind), "constraint_"))
+	}
+
+	if c.Old != nil || c.New != nil {
+		sb.WriteString(" (" + formatChangeValue(c.Old) + " -> " + formatChangeValue(c.New) + ")")
+	}
+
+	if c.Breaking {
+		sb.WriteString(" [breaking]")
+	}
+
+	return sb.String()
+}
+
+// formatChangeValue formats the old or new side of a change
+func formatChangeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "none"
+	case ConstraintInfo:
+		return formatConstraint(v)
+	case string:
+		return v
+	}
+
+	return formatParam(value)
+}
+
+// SchemaDiff holds the changes between two schemas
+type SchemaDiff []Change
+
+// Breaking returns the breaking changes
+func (d SchemaDiff) Breaking() SchemaDiff {
+	var breaking SchemaDiff
+	for _, change := range d {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		}
+	}
+
+	return breaking
+}
+
+// HasBreaking reports whether at least one change is breaking
+func (d SchemaDiff) HasBreaking() bool {
+	return len(d.Breaking()) > 0
+}
+
+// String returns the changes, one per line
+func (d SchemaDiff) String() string {
+	lines := make([]string, len(d))
+	for i, change := range d {
+		lines[i] = change.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff compares two schemas from the point of view of their inputs, e.g. to fail a CI job when a release
+// introduces breaking API changes:
+//
+//	if diff := poxxy.Diff(previousSchema, currentSchema); diff.HasBreaking() {
+//		log.Fatalf("breaking changes:\n%s", diff.Breaking())
+//	}
+//
+// Fields are matched by name, sub-schema fields being reported with their dotted path.
+// Removed fields, new required fields, type and default changes and tightened constraints are breaking;
+// new optional fields and loosened constraints aren't. Only constraints of validators implementing Describer
+// are compared, as returned by Describe. Changes are reported in the order of the old schema fields,
+// followed by the added fields.
+func Diff(oldSchema, newSchema *Schema) SchemaDiff {
+	return diffFields("", oldSchema.Describe(), newSchema.Describe())
+}
+
+// diffFields compares two lists of fields, prefix being the path of their parent
+func diffFields(prefix string, oldFields, newFields []FieldInfo) SchemaDiff {
+	var diff SchemaDiff
+
+	newByName := make(map[string]FieldInfo, len(newFields))
+	for _, info := range newFields {
+		newByName[info.Name] = info
+	}
+
+	oldNames := make(map[string]bool, len(oldFields))
+	for _, oldInfo := range oldFields {
+		oldNames[oldInfo.Name] = true
+		path := prefix + oldInfo.Name
+
+		newInfo, ok := newByName[oldInfo.Name]
+		if !ok {
+			diff = append(diff, Change{Field: path, Kind: FieldRemoved, Breaking: true})
+			continue
+		}
+
+		diff = append(diff, diffField(path, oldInfo, newInfo)...)
+	}
+
+	for _, newInfo := range newFields {
+		if oldNames[newInfo.Name] {
+			continue
+		}
+
+		diff = append(diff, Change{
+			Field:    prefix + newInfo.Name,
+			Kind:     FieldAdded,
+			Breaking: hasConstraint(newInfo.Constraints, "required"),
+		})
+	}
+
+	return diff
+}
+
+// diffField compares two versions of a field
+func diffField(path string, oldInfo, newInfo FieldInfo) SchemaDiff {
+	var diff SchemaDiff
+
+	if oldInfo.Type != newInfo.Type {
+		diff = append(diff, Change{Field: path, Kind: TypeChanged, Old: oldInfo.Type, New: newInfo.Type, Breaking: true})
+	}
+
+	if oldInfo.HasDefault != newInfo.HasDefault || !reflect.DeepEqual(oldInfo.Default, newInfo.Default) {
+		change := Change{Field: path, Kind: DefaultChanged, Breaking: true}
+		if oldInfo.HasDefault {
+			change.Old = oldInfo.Default
+		}
+		if newInfo.HasDefault {
+			change.New = newInfo.Default
+		}
+		diff = append(diff, change)
+	}
+
+	diff = append(diff, diffConstraints(path, oldInfo.Constraints, newInfo.Constraints)...)
+	diff = append(diff, diffFields(path+".", oldInfo.Fields, newInfo.Fields)...)
+
+	return diff
+}
+
+// diffConstraints compares the constraints of a field. Constraints are matched by kind,
+// in order when a kind appears several times.
+func diffConstraints(path string, oldConstraints, newConstraints []ConstraintInfo) SchemaDiff {
+	var diff SchemaDiff
+
+	newByKind := make(map[string][]ConstraintInfo)
+	for _, info := range newConstraints {
+		newByKind[info.Kind] = append(newByKind[info.Kind], info)
+	}
+
+	matched := make(map[string]int)
+	for _, oldInfo := range oldConstraints {
+		index := matched[oldInfo.Kind]
+		if index >= len(newByKind[oldInfo.Kind]) {
+			diff = append(diff, Change{Field: path, Kind: ConstraintLoosened, Constraint: oldInfo.Kind, Old: oldInfo})
+			continue
+		}
+
+		newInfo := newByKind[oldInfo.Kind][index]
+		matched[oldInfo.Kind]++
+
+		if kind := compareConstraint(oldInfo, newInfo); kind != "" {
+			diff = append(diff, Change{
+				Field:      path,
+				Kind:       kind,
+				Constraint: oldInfo.Kind,
+				Old:        oldInfo,
+				New:        newInfo,
+				Breaking:   kind != ConstraintLoosened,
+			})
+		}
+	}
+
+	// The constraints left once the old ones are matched are new
+	seen := make(map[string]int)
+	for _, newInfo := range newConstraints {
+		seen[newInfo.Kind]++
+		if seen[newInfo.Kind] <= matched[newInfo.Kind] {
+			continue
+		}
+
+		diff = append(diff, Change{Field: path, Kind: ConstraintTightened, Constraint: newInfo.Kind, New: newInfo, Breaking: true})
+	}
+
+	return diff
+}
+
+// compareConstraint returns how a constraint changed, or "" when it didn't
+func compareConstraint(oldInfo, newInfo ConstraintInfo) ChangeKind {
+	if reflect.DeepEqual(oldInfo.Params, newInfo.Params) {
+		return ""
+	}
+
+	// Bounds with a single parameter named after the constraint (e.g. min=18, max_length=255)
+	oldBound, oldOk := oldInfo.Params[oldInfo.Kind]
+	newBound, newOk := newInfo.Params[newInfo.Kind]
+	if oldOk && newOk && len(oldInfo.Params) == 1 && len(newInfo.Params) == 1 {
+		oldN, _, oldErr := toFloat64(oldBound)
+		newN, _, newErr := toFloat64(newBound)
+		if oldErr == nil && newErr == nil {
+			switch {
+			case newN == oldN:
+				// Same bound with another type, e.g. Min(18) and Min(18.0)
+				return ""
+			case strings.HasPrefix(oldInfo.Kind, "min"):
+				return orderedChange(newN > oldN)
+			case strings.HasPrefix(oldInfo.Kind, "max"):
+				return orderedChange(newN < oldN)
+			}
+		}
+	}
+
+	// Allowed values
+	if oldInfo.Kind == "in" {
+		oldValues := constraintValues(oldInfo.Params["values"])
+		newValues := constraintValues(newInfo.Params["values"])
+		switch {
+		case isSubset(newValues, oldValues) && isSubset(oldValues, newValues):
+			// Same values, reordered
+			return ""
+		case isSubset(newValues, oldValues):
+			return ConstraintTightened
+		case isSubset(oldValues, newValues):
+			return ConstraintLoosened
+		}
+	}
+
+	return ConstraintChanged
+}
+
+// orderedChange returns ConstraintTightened when tightened is set, ConstraintLoosened otherwise
+func orderedChange(tightened bool) ChangeKind {
+	if tightened {
+		return ConstraintTightened
+	}
+
+	return ConstraintLoosened
+}
+
+// constraintValues returns the values of a slice parameter
+func constraintValues(param interface{}) []interface{} {
+	rv := reflect.ValueOf(param)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+
+	return values
+}
+
+// isSubset reports whether every value of a is in b
+func isSubset(a, b []interface{}) bool {
+	for _, value := range a {
+		found := false
+		for _, other := range b {
+			if reflect.DeepEqual(value, other) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasConstraint reports whether the constraints contain the given kind
+func hasConstraint(infos []ConstraintInfo, kind string) bool {
+	for _, info := range infos {
+		if info.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
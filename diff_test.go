@@ -0,0 +1,121 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+
+	var name, role, nickname, email string
+	var age, page int
+	var address Address
+
+	oldSchema := NewSchema(
+		Value("name", &name, WithValidators(Required(), MaxLength(50))),
+		Value("age", &age, WithValidators(Min(18))),
+		Value("role", &role, WithValidators(In("member", "admin"))),
+		Value("nickname", &nickname),
+		Value("page", &page, WithDefault(1)),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("city", &a.City, WithValidators(Required())))
+		})),
+	)
+
+	newSchema := NewSchema(
+		Value("name", &name, WithValidators(MaxLength(100))),
+		Value("age", &age, WithValidators(Min(21))),
+		Value("role", &role, WithValidators(In("member", "admin", "owner"))),
+		Value("page", &page, WithDefault(1), WithValidators(Min(1))),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("city", &a.City, WithValidators(Required())))
+			WithSchema(s, Value("zip", &a.Zip, WithValidators(Required())))
+		})),
+		Value("email", &email, WithValidators(Email())),
+	)
+
+	diff := Diff(oldSchema, newSchema)
+	assert.Equal(t, []string{
+		"name: constraint required loosened (required -> none)",
+		"name: constraint max_length loosened (max_length=50 -> max_length=100)",
+		"age: constraint min tightened (min=18 -> min=21) [breaking]",
+		"role: constraint in loosened (in(values=[member admin]) -> in(values=[member admin owner]))",
+		"nickname: field removed [breaking]",
+		"page: constraint min tightened (none -> min=1) [breaking]",
+		"address.zip: field added [breaking]",
+		"email: field added",
+	}, changeStrings(diff))
+
+	assert.True(t, diff.HasBreaking())
+	assert.Equal(t, []string{
+		"age: constraint min tightened (min=18 -> min=21) [breaking]",
+		"nickname: field removed [breaking]",
+		"page: constraint min tightened (none -> min=1) [breaking]",
+		"address.zip: field added [breaking]",
+	}, changeStrings(diff.Breaking()))
+
+	t.Run("identical schemas", func(t *testing.T) {
+		diff := Diff(oldSchema, oldSchema)
+		assert.Empty(t, diff)
+		assert.False(t, diff.HasBreaking())
+	})
+
+	t.Run("type and default changes", func(t *testing.T) {
+		var limit int
+		var limit64 int64
+		var sort string
+
+		diff := Diff(
+			NewSchema(Value("limit", &limit), Value("sort", &sort, WithDefault("asc"))),
+			NewSchema(Value("limit", &limit64), Value("sort", &sort, WithDefault("desc"))),
+		)
+		assert.Equal(t, []Change{
+			{Field: "limit", Kind: TypeChanged, Old: "int", New: "int64", Breaking: true},
+			{Field: "sort", Kind: DefaultChanged, Old: "asc", New: "desc", Breaking: true},
+		}, []Change(diff))
+	})
+
+	t.Run("fewer allowed values and unordered changes", func(t *testing.T) {
+		var status, code string
+
+		diff := Diff(
+			NewSchema(Value("status", &status, WithValidators(In("draft", "published"))), Value("code", &code, WithValidators(PostalCode("FR")))),
+			NewSchema(Value("status", &status, WithValidators(In("published"))), Value("code", &code, WithValidators(PostalCode("DE")))),
+		)
+		assert.Equal(t, []ChangeKind{ConstraintTightened, ConstraintChanged}, []ChangeKind{diff[0].Kind, diff[1].Kind})
+		assert.True(t, diff[1].Breaking)
+	})
+
+	t.Run("same bound with another type", func(t *testing.T) {
+		var age float64
+
+		diff := Diff(
+			NewSchema(Value("age", &age, WithValidators(Min(18)))),
+			NewSchema(Value("age", &age, WithValidators(Min(18.0)))),
+		)
+		assert.Empty(t, diff)
+	})
+
+	t.Run("reordered allowed values", func(t *testing.T) {
+		var status string
+
+		diff := Diff(
+			NewSchema(Value("status", &status, WithValidators(In("draft", "published")))),
+			NewSchema(Value("status", &status, WithValidators(In("published", "draft")))),
+		)
+		assert.Empty(t, diff)
+	})
+}
+
+func changeStrings(diff SchemaDiff) []string {
+	lines := make([]string, len(diff))
+	for i, change := range diff {
+		lines[i] = change.String()
+	}
+	return lines
+}
@@ -0,0 +1,183 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseDuration parses a duration in Go notation ("90s", "1h30m") or ISO 8601 notation ("PT1H30M", "P1DT2H")
+func parseDuration(str string) (time.Duration, error) {
+	if d, err := time.ParseDuration(str); err == nil {
+		return d, nil
+	}
+
+	if d, err := parseISO8601Duration(str); err == nil {
+		return d, nil
+	} else if isISO8601Duration(str) {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("invalid duration %q, expected a duration like 90s or PT1H30M", str)
+}
+
+// convertDuration converts a duration string (see parseDuration), or an integer number of unit given as a
+// number or a string of digits, to a time.Duration
+func convertDuration(value interface{}, unit time.Duration) (time.Duration, error) {
+	if n, ok := value.(json.Number); ok {
+		value = n.String()
+	}
+
+	var count int64
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.String:
+		d, err := parseDuration(v.String())
+		if err == nil || isISO8601Duration(v.String()) {
+			return d, err
+		}
+		if count, err = strconv.ParseInt(v.String(), 10, 64); err != nil {
+			return 0, fmt.Errorf("invalid duration %q, expected a duration like 90s or PT1H30M, or an integer", v.String())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if d, ok := value.(time.Duration); ok {
+			return d, nil
+		}
+		count = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("duration %v is out of range", value)
+		}
+		count = int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		if v.Float() != math.Trunc(v.Float()) {
+			return 0, fmt.Errorf("invalid duration %v, expected an integer", value)
+		}
+		if v.Float() < -(1<<63) || v.Float() >= 1<<63 {
+			return 0, fmt.Errorf("duration %v is out of range", value)
+		}
+		count = int64(v.Float())
+	default:
+		return 0, fmt.Errorf("expected a duration like 90s or PT1H30M, got %T", value)
+	}
+
+	if count > math.MaxInt64/int64(unit) || count < math.MinInt64/int64(unit) {
+		return 0, fmt.Errorf("duration %v is out of range", value)
+	}
+
+	return time.Duration(count) * unit, nil
+}
+
+// DurationUnitOption holds the unit of the integer durations accepted by a field
+type DurationUnitOption struct {
+	unit time.Duration
+}
+
+// Apply applies the duration unit to a field
+func (o DurationUnitOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetDurationUnit(time.Duration) }); ok {
+		f.SetDurationUnit(o.unit)
+	} else {
+		panic(fmt.Sprintf("WithDurationUnit doesn't support %T", field))
+	}
+}
+
+// WithDurationUnit sets the unit of the integers accepted by a time.Duration Value or Pointer field,
+// nanoseconds by default (as encoding/json encodes durations). Strings like "30s" are still accepted.
+//
+//	poxxy.Value("timeout_ms", &timeout, poxxy.WithDurationUnit(time.Millisecond))
+func WithDurationUnit(unit time.Duration) Option {
+	if unit <= 0 {
+		panic("WithDurationUnit requires a positive unit")
+	}
+
+	return DurationUnitOption{unit: unit}
+}
+
+// isISO8601Duration reports whether a string looks like an ISO 8601 duration
+func isISO8601Duration(str string) bool {
+	str = strings.TrimPrefix(str, "-")
+	return len(str) > 1 && (str[0] == 'P' || str[0] == 'p')
+}
+
+// iso8601Units holds the length of the ISO 8601 duration units, in the date and time parts.
+// Years and months are not supported as their length varies.
+var iso8601Units = map[bool]map[byte]time.Duration{
+	false: {'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour},
+	true:  {'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+}
+
+// parseISO8601Duration parses an ISO 8601 duration like "P1DT2H30M" or "PT0.5S".
+// Weeks are 7 days and days are 24 hours; years and months are rejected as their length varies.
+func parseISO8601Duration(str string) (time.Duration, error) {
+	if !isISO8601Duration(str) {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+	}
+
+	rest := strings.ToUpper(str)
+	negative := strings.HasPrefix(rest, "-")
+	rest = strings.TrimPrefix(rest, "-")[1:]
+
+	var total float64
+	inTime := false
+	components := 0
+	// Designators are given once each, from the largest unit to the smallest (e.g. not PT1M1H nor PT1H1H)
+	previous := time.Duration(math.MaxInt64)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if end <= 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+		}
+
+		n, err := strconv.ParseFloat(strings.Replace(rest[:end], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+		}
+
+		designator := rest[end]
+		unit, ok := iso8601Units[inTime][designator]
+		if !ok {
+			if !inTime && (designator == 'Y' || designator == 'M') {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q: years and months are not supported", str)
+			}
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+		}
+
+		if unit >= previous {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+		}
+		previous = unit
+
+		total += n * float64(unit)
+		components++
+		rest = rest[end+1:]
+	}
+
+	if components == 0 {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", str)
+	}
+
+	if total >= 1<<63 {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q: out of range", str)
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return time.Duration(math.Round(total)), nil
+}
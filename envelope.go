@@ -0,0 +1,56 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WithEnvelope creates a schema option applying the schema to the object found at the dotted path of the
+// input, e.g. the "data" of a {"data": {...}, "meta": {...}} envelope, so schemas written for the inner
+// object don't have to nest every field. The other keys of the envelope are ignored.
+// The paths of the errors start with the envelope (e.g. Path{"data", "name"}).
+// Like WithContext, it only applies to the Apply it is given to.
+func WithEnvelope(path string) SchemaOption {
+	return func(s *Schema) {
+		s.envelope = strings.Split(path, ".")
+	}
+}
+
+// unwrapEnvelope returns the object at the envelope path of data, or an error if it is missing or not an object
+func (s *Schema) unwrapEnvelope(data map[string]interface{}) (map[string]interface{}, error) {
+	value, _ := getPath(data, s.envelope)
+
+	var err error
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case nil:
+		err = errRequired
+	default:
+		err = fmt.Errorf("expected object, got %T", value)
+	}
+
+	path := make(Path, len(s.envelope))
+	for i, key := range s.envelope {
+		path[i] = key
+	}
+
+	errors := Errors{{Field: path.String(), Error: err, Path: path}}
+	setErrorDetails(errors)
+	return nil, errors
+}
+
+// prefixEnvelope prepends the envelope path to the paths of the errors
+func (s *Schema) prefixEnvelope(errors Errors) {
+	if s.envelope == nil {
+		return
+	}
+
+	for i := range errors {
+		path := make(Path, 0, len(s.envelope)+len(errors[i].Path))
+		for _, key := range s.envelope {
+			path = append(path, key)
+		}
+		errors[i].Path = append(path, errors[i].Path...)
+	}
+}
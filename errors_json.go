@@ -0,0 +1,49 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// errorEntry is the machine-readable representation of a field error
+type errorEntry struct {
+	Field     string `json:"field" xml:"field"`
+	Path      Path   `json:"path,omitempty" xml:"-"`
+	Code      string `json:"code,omitempty" xml:"code,omitempty"`
+	Message   string `json:"message" xml:"message"`
+	Secondary bool   `json:"secondary,omitempty" xml:"-"`
+}
+
+// MarshalJSON implements json.Marshaler. Errors are flattened (see Flatten) and rendered as a list of
+// {"field", "path", "code", "message"} objects, secondary errors having "secondary": true:
+//
+//	[{"field": "people[1].name", "path": ["people", 1, "name"], "code": "required", "message": "field is required"}]
+func (e Errors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Flatten().entries())
+}
+
+// entries returns the entries of flattened errors
+func (e Errors) entries() []errorEntry {
+	entries := make([]errorEntry, 0, len(e))
+	for _, fieldError := range e {
+		entries = append(entries, errorEntry{
+			Field:     fieldError.Field,
+			Path:      fieldError.Path,
+			Code:      fieldError.Code,
+			Message:   fieldError.Error.Error(),
+			Secondary: fieldError.Secondary,
+		})
+	}
+
+	return entries
+}
+
+// validationErrorCode returns the code of the ValidationError wrapped in err, if any
+func validationErrorCode(err error) string {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Code
+	}
+
+	return ""
+}
@@ -0,0 +1,177 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Encoding is the encoding of an event payload
+type Encoding uint8
+
+const (
+	_                     = iota
+	EncodingJSON Encoding = iota // A JSON object
+	EncodingForm                 // URL-encoded values, like a form body
+)
+
+// EventError is the error of an event applied with ApplyEvent, carrying the context given with
+// WithEventFields (e.g. the topic, partition and offset of a Kafka message) so consumers can log it
+type EventError struct {
+	Fields map[string]interface{}
+	Err    error
+}
+
+// Error returns the error prefixed by the fields of the event, sorted by name
+func (e *EventError) Error() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString("event")
+	for _, name := range names {
+		fmt.Fprintf(&sb, " %s=%v", name, e.Fields[name])
+	}
+
+	return sb.String() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error of the event, e.g. the Errors of the schema
+func (e *EventError) Unwrap() error {
+	return e.Err
+}
+
+// WithEventFields creates a schema option giving the context of the event applied with ApplyEvent,
+// returned in its EventError
+func WithEventFields(fields map[string]interface{}) SchemaOption {
+	return func(s *Schema) {
+		s.eventFields = fields
+	}
+}
+
+// ApplyEvent assigns the payload of a message (e.g. a Kafka record) to a schema, the headers of the message
+// being read by the FromHeader declarations. Errors are wrapped in an EventError when the caller gives the
+// context of the event with WithEventFields:
+//
+//	err := schema.ApplyEvent(headers, record.Value, poxxy.EncodingJSON, poxxy.WithEventFields(map[string]interface{}{
+//		"topic": record.Topic, "partition": record.Partition, "offset": record.Offset,
+//	}))
+func (s *Schema) ApplyEvent(headers map[string][]byte, payload []byte, encoding Encoding, options ...SchemaOption) error {
+	// Options given by the caller win over the headers of the message
+	options = append([]SchemaOption{WithRequestHeader(eventHeader(headers))}, options...)
+
+	// The options are applied first, so the errors of the payload carry the fields of the event too
+	s.configure(options)
+	data, err := s.decodeEvent(payload, encoding)
+	if err == nil {
+		err = s.apply(data)
+	}
+
+	return s.eventError(err)
+}
+
+// decodeEvent decodes an event payload into the data given to Apply
+func (s *Schema) decodeEvent(payload []byte, encoding Encoding) (map[string]interface{}, error) {
+	switch encoding {
+	case EncodingJSON:
+		var data map[string]interface{}
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event payload: %w", err)
+		}
+		return data, nil
+	case EncodingForm:
+		values, err := url.ParseQuery(string(payload))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse event payload: %w", err)
+		}
+		return s.urlValuesData(values), nil
+	default:
+		return nil, fmt.Errorf("unsupported event encoding %d", encoding)
+	}
+}
+
+// eventHeader converts the headers of a message to an http.Header, read by FromHeader declarations
+func eventHeader(headers map[string][]byte) http.Header {
+	header := make(http.Header, len(headers))
+	for name, value := range headers {
+		header.Set(name, string(value))
+	}
+
+	return header
+}
+
+// eventError wraps err in an EventError when the schema was given the fields of the event
+func (s *Schema) eventError(err error) error {
+	if err == nil || s.eventFields == nil {
+		return err
+	}
+
+	return &EventError{Fields: s.eventFields, Err: err}
+}
+
+// rejectEvent returns err as the error of an event without a schema, applying the options to a schema
+// rejecting it so err carries the fields of the event like the errors of the event schemas
+func rejectEvent(err error, options []SchemaOption) error {
+	schema := NewSchema(Invariant(func(*Schema) error {
+		return err
+	}))
+
+	return schema.eventError(schema.Apply(nil, options...))
+}
+
+// EventSchema selects the schema of an event by its type, read from a header of the message
+type EventSchema struct {
+	header  string
+	schemas map[string]*Schema
+}
+
+// EventTypes creates an EventSchema selecting the schema of each event by the value of the given header,
+// for consumers of topics carrying several types of events:
+//
+//	events := poxxy.EventTypes("event_type", map[string]*poxxy.Schema{
+//		"order.created":  poxxy.NewSchema(poxxy.Value("order_id", &created.OrderID)),
+//		"order.canceled": poxxy.NewSchema(poxxy.Value("order_id", &canceled.OrderID), poxxy.Value("reason", &canceled.Reason)),
+//	})
+//
+//	eventType, err := events.ApplyEvent(headers, payload, poxxy.EncodingJSON)
+func EventTypes(header string, schemas map[string]*Schema) *EventSchema {
+	return &EventSchema{
+		header:  header,
+		schemas: schemas,
+	}
+}
+
+// Types returns the supported event types, sorted
+func (e *EventSchema) Types() []string {
+	types := make([]string, 0, len(e.schemas))
+	for eventType := range e.schemas {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// ApplyEvent applies an event to the schema of its type, like Schema.ApplyEvent.
+// It returns the type of the event so the consumer knows which bound variables were filled.
+func (e *EventSchema) ApplyEvent(headers map[string][]byte, payload []byte, encoding Encoding, options ...SchemaOption) (string, error) {
+	eventType := strings.TrimSpace(string(headers[e.header]))
+	if eventType == "" {
+		err := fmt.Errorf("missing event type header %q, supported types are %s", e.header, strings.Join(e.Types(), ", "))
+		return "", rejectEvent(err, options)
+	}
+
+	schema, ok := e.schemas[eventType]
+	if !ok {
+		err := fmt.Errorf("unsupported event type %q, supported types are %s", eventType, strings.Join(e.Types(), ", "))
+		return eventType, rejectEvent(err, options)
+	}
+
+	return eventType, schema.ApplyEvent(headers, payload, encoding, options...)
+}
@@ -0,0 +1,92 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_ApplyEvent(t *testing.T) {
+	var orderID, tenant string
+	var quantity int
+	schema := NewSchema(
+		Value("order_id", &orderID, WithValidators(Required())),
+		Value("quantity", &quantity, WithValidators(Min(1))),
+		Value("tenant", &tenant),
+		FromHeader("X-Tenant", "tenant"),
+	)
+
+	headers := map[string][]byte{"x-tenant": []byte("acme")}
+
+	require.NoError(t, schema.ApplyEvent(headers, []byte(`{"order_id": "o-1", "quantity": 2}`), EncodingJSON))
+	assert.Equal(t, "o-1", orderID)
+	assert.Equal(t, 2, quantity)
+	assert.Equal(t, "acme", tenant)
+
+	require.NoError(t, schema.ApplyEvent(nil, []byte(`order_id=o-2&quantity=3`), EncodingForm))
+	assert.Equal(t, "o-2", orderID)
+	assert.Equal(t, 3, quantity)
+
+	t.Run("errors carry the fields of the event", func(t *testing.T) {
+		fields := map[string]interface{}{"topic": "orders", "partition": 3, "offset": int64(42)}
+
+		err := schema.ApplyEvent(headers, []byte(`{"quantity": 0}`), EncodingJSON, WithEventFields(fields))
+		var eventErr *EventError
+		require.ErrorAs(t, err, &eventErr)
+		assert.Equal(t, fields, eventErr.Fields)
+		assert.EqualError(t, err, "event offset=42 partition=3 topic=orders: order_id: field is required; quantity: value must be at least 1")
+
+		var errs Errors
+		require.ErrorAs(t, err, &errs)
+		assert.Len(t, errs, 2)
+
+		err = schema.ApplyEvent(headers, []byte(`{`), EncodingJSON, WithEventFields(fields))
+		require.ErrorAs(t, err, &eventErr)
+		assert.ErrorContains(t, err, "event offset=42 partition=3 topic=orders: failed to unmarshal event payload")
+	})
+
+	t.Run("options are applied once", func(t *testing.T) {
+		applied := 0
+		option := func(s *Schema) {
+			applied++
+			WithEventFields(map[string]interface{}{"offset": applied})(s)
+		}
+
+		err := schema.ApplyEvent(headers, []byte(`{`), EncodingJSON, option)
+		assert.ErrorContains(t, err, "event offset=1: failed to unmarshal event payload")
+		assert.Equal(t, 1, applied)
+	})
+
+	t.Run("without fields", func(t *testing.T) {
+		err := schema.ApplyEvent(nil, []byte(`{"quantity": 1}`), EncodingJSON)
+		assert.EqualError(t, err, "order_id: field is required")
+
+		err = schema.ApplyEvent(nil, []byte(`{}`), Encoding(0))
+		assert.EqualError(t, err, "unsupported event encoding 0")
+	})
+}
+
+func TestEventTypes(t *testing.T) {
+	var createdID, canceledID, reason string
+	events := EventTypes("event_type", map[string]*Schema{
+		"order.created":  NewSchema(Value("order_id", &createdID)),
+		"order.canceled": NewSchema(Value("order_id", &canceledID), Value("reason", &reason, WithValidators(Required()))),
+	})
+
+	assert.Equal(t, []string{"order.canceled", "order.created"}, events.Types())
+
+	eventType, err := events.ApplyEvent(map[string][]byte{"event_type": []byte("order.canceled")}, []byte(`{"order_id": "o-1", "reason": "late"}`), EncodingJSON)
+	require.NoError(t, err)
+	assert.Equal(t, "order.canceled", eventType)
+	assert.Equal(t, "o-1", canceledID)
+	assert.Empty(t, createdID)
+
+	_, err = events.ApplyEvent(nil, []byte(`{}`), EncodingJSON)
+	assert.EqualError(t, err, `missing event type header "event_type", supported types are order.canceled, order.created`)
+
+	fields := map[string]interface{}{"offset": 7}
+	eventType, err = events.ApplyEvent(map[string][]byte{"event_type": []byte("order.shipped")}, []byte(`{}`), EncodingJSON, WithEventFields(fields))
+	assert.Equal(t, "order.shipped", eventType)
+	assert.EqualError(t, err, `event offset=7: unsupported event type "order.shipped", supported types are order.canceled, order.created`)
+}
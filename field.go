@@ -1,21 +1,92 @@
 package poxxy
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Path locates a value in the input: its elements are object keys (string) and list indexes (int)
+type Path []interface{}
+
+// String returns the path in dotted notation with bracketed indexes, e.g. "people[1].name"
+func (p Path) String() string {
+	var sb strings.Builder
+	for _, element := range p {
+		if index, ok := element.(int); ok {
+			sb.WriteString("[" + strconv.Itoa(index) + "]")
+			continue
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(fmt.Sprint(element))
+	}
+
+	return sb.String()
+}
+
+// append returns a copy of the path with the given elements appended
+func (p Path) append(elements ...interface{}) Path {
+	path := make(Path, 0, len(p)+len(elements))
+	path = append(path, p...)
+	return append(path, elements...)
+}
 
 // FieldError represents a validation error for a specific field
 type FieldError struct {
 	Field       string
 	Description string
 	Error       error
+	// Path locates the failing value in the input. Apply sets it to the field name; Errors.Flatten
+	// extends it into sub-schemas, slices and maps (e.g. Path{"people", 1, "name"}).
+	Path Path
+	// Code is the stable machine-readable code of the failure (e.g. "required", "min") when Error is
+	// a ValidationError, as returned by the built-in validators, so clients can translate messages
+	Code string
+	// Secondary is set on the validation errors of a field whose assignment failed (e.g. a Min error
+	// after "not a number"), as they follow from the assignment error
+	Secondary bool
+}
+
+// path returns the path of the error, the field name when it isn't set.
+// Errors of the schema itself (see Invariant) have an empty field name and path.
+func (e FieldError) path() Path {
+	if e.Path != nil {
+		return e.Path
+	}
+	if e.Field == "" {
+		return Path{}
+	}
+
+	return Path{e.Field}
 }
 
 // Errors represents multiple validation errors
 type Errors []FieldError
 
+// Primary returns the errors without the secondary ones, so each failing field is reported by its root cause
+func (e Errors) Primary() Errors {
+	primary := make(Errors, 0, len(e))
+	for _, fieldError := range e {
+		if !fieldError.Secondary {
+			primary = append(primary, fieldError)
+		}
+	}
+
+	return primary
+}
+
 // Error returns a string representation of all validation errors
 func (e Errors) Error() string {
 	var msgs []string
 	for _, err := range e {
+		if err.Field == "" {
+			msgs = append(msgs, err.Error.Error())
+			continue
+		}
 		msgs = append(msgs, fmt.Sprintf("%s: %v", err.Field, err.Error))
 	}
 	// Manual join instead of using strings.Join
@@ -29,6 +100,100 @@ func (e Errors) Error() string {
 	return result
 }
 
+// Flatten returns the errors of the values that failed, sub-schema, element and map key errors being replaced
+// by the errors they contain. Each error has its full Path, and its Field set to the path string
+// (e.g. "people[1].name"), so API handlers can map errors back to the inputs:
+//
+//	for _, fieldError := range errs.Flatten() {
+//		fmt.Println(fieldError.Field, fieldError.Error) // people[1].name field is required
+//	}
+func (e Errors) Flatten() Errors {
+	var flat Errors
+	for _, fieldError := range e {
+		flat = flattenError(flat, fieldError.path(), fieldError)
+	}
+
+	return flat
+}
+
+// flattenError appends the leaf errors of fieldError, located at path, to flat
+func flattenError(flat Errors, path Path, fieldError FieldError) Errors {
+	child := func(err error) FieldError {
+		return FieldError{Field: fieldError.Field, Description: fieldError.Description, Error: err, Secondary: fieldError.Secondary}
+	}
+
+	var nested Errors
+	switch err := fieldError.Error.(type) {
+	case ElementErrors:
+		for _, elementError := range err {
+			flat = flattenError(flat, path.append(elementError.Index), child(elementError.Error))
+		}
+		return flat
+	case KeyErrors:
+		for _, keyError := range err {
+			flat = flattenError(flat, path.append(keyError.Key), child(keyError.Error))
+		}
+		return flat
+	default:
+		if !errors.As(err, &nested) {
+			fieldError.Path = path
+			fieldError.Field = path.String()
+			fieldError.Code = validationErrorCode(err)
+			return append(flat, fieldError)
+		}
+	}
+
+	for _, nestedError := range nested {
+		flat = flattenError(flat, path.append(nestedError.path()...), nestedError)
+	}
+
+	return flat
+}
+
+// ElementError represents a validation error for a specific element of a slice or array
+type ElementError struct {
+	Index int
+	Error error
+}
+
+// ElementErrors represents validation errors for multiple elements of a slice or array
+type ElementErrors []ElementError
+
+// Error returns a string representation of all element errors
+func (e ElementErrors) Error() string {
+	var msgs []string
+	for _, err := range e {
+		msgs = append(msgs, fmt.Sprintf("element %d: %v", err.Index, err.Error))
+	}
+	if len(msgs) == 0 {
+		return ""
+	}
+	result := msgs[0]
+	for i := 1; i < len(msgs); i++ {
+		result += "; " + msgs[i]
+	}
+	return result
+}
+
+// KeyError represents a validation error for a specific key of a map
+type KeyError struct {
+	Key   string
+	Error error
+}
+
+// KeyErrors represents validation errors for multiple keys of a map
+type KeyErrors []KeyError
+
+// Error returns a string representation of all key errors
+func (e KeyErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, fmt.Sprintf("key %q: %v", err.Key, err.Error))
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
 // DescriptionOption holds a description
 type DescriptionOption struct {
 	description string
@@ -48,7 +213,8 @@ func WithDescription(description string) Option {
 type Field interface {
 	// Name returns the name of the field
 	Name() string
-	// Value returns the current value of the field
+	// Value returns the current value of the field, or nil if it was not assigned
+	// (from the input or from its default value) during the last Apply
 	Value() interface{}
 	// Description returns the description of the field
 	Description() string
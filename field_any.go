@@ -0,0 +1,125 @@
+package poxxy
+
+// AnyField represents a field intentionally left dynamic (arbitrary JSON object, array or scalar)
+type AnyField struct {
+	name        string
+	description string
+	ptr         *interface{}
+	Validators  []Validator
+	wasAssigned bool // Track if a non-nil value was assigned
+	defaulted   bool // Track if the default value was applied
+	defaultVal  interface{}
+	hasDefault  bool
+}
+
+// Name returns the field name
+func (f *AnyField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *AnyField) Value() interface{} {
+	if f.ptr == nil || !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *AnyField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *AnyField) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultValue sets the default value for the field
+func (f *AnyField) SetDefaultValue(defaultValue interface{}) {
+	f.defaultVal = defaultValue
+	f.hasDefault = true
+}
+
+// Assign assigns the raw input value to the field, without conversion
+func (f *AnyField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		// Apply default value if available
+		if f.hasDefault {
+			*f.ptr = f.defaultVal
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		}
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	*f.ptr = value
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *AnyField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *AnyField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *AnyField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*interface{})
+	return &clone
+}
+
+// Validate validates the field value using all registered validators
+func (f *AnyField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, f.Value(), f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *AnyField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *AnyField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *AnyField) describeField(info *FieldInfo) {
+	info.Type = "any"
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultVal
+	}
+}
+
+// Any creates a field keeping the raw input value (map[string]interface{}, []interface{}, string, float64, bool)
+// for payloads intentionally left dynamic. Use OneOfKinds, MaxDepth and MaxSize to constrain its shape.
+//
+//	var payload interface{}
+//	poxxy.Any("payload", &payload, poxxy.WithValidators(poxxy.OneOfKinds(poxxy.KindObject, poxxy.KindArray), poxxy.MaxDepth(5)))
+func Any(name string, ptr *interface{}, opts ...Option) Field {
+	field := &AnyField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
@@ -0,0 +1,59 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnyField(t *testing.T) {
+	var payload interface{}
+
+	schema := NewSchema(
+		Any("payload", &payload, WithValidators(
+			Required(),
+			OneOfKinds(KindObject, KindArray),
+			MaxDepth(2),
+			MaxSize(64),
+		)),
+	)
+
+	t.Run("object", func(t *testing.T) {
+		err := schema.ApplyJSON([]byte(`{"payload": {"a": 1, "b": [true, "x"]}}`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"a": 1.0, "b": []interface{}{true, "x"}}, payload)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		require.NoError(t, schema.ApplyJSON([]byte(`{"payload": [1, 2]}`)))
+		assert.Equal(t, []interface{}{1.0, 2.0}, payload)
+	})
+
+	t.Run("wrong kind", func(t *testing.T) {
+		err := schema.ApplyJSON([]byte(`{"payload": 42}`))
+		assert.EqualError(t, err, "payload: must be one of: object, array (got number)")
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		err := schema.ApplyJSON([]byte(`{"payload": {"a": {"b": {"c": 1}}}}`))
+		assert.EqualError(t, err, "payload: must not be nested deeper than 2 levels")
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		err := schema.ApplyJSON([]byte(`{"payload": ["aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"]}`))
+		assert.EqualError(t, err, "payload: must be at most 64 bytes once encoded")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		err := schema.ApplyJSON([]byte(`{}`))
+		assert.EqualError(t, err, "payload: field is required")
+	})
+}
+
+func TestMaxDepth(t *testing.T) {
+	assert.NoError(t, MaxDepth(0).Validate("scalar", "v"))
+	assert.Error(t, MaxDepth(0).Validate([]interface{}{}, "v"))
+	assert.NoError(t, MaxDepth(1).Validate(map[string]interface{}{"a": 1}, "v"))
+	assert.Error(t, MaxDepth(1).Validate(map[string]interface{}{"a": []interface{}{1}}, "v"))
+}
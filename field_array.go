@@ -12,6 +12,7 @@ type ArrayField[T any] struct {
 	ptr          interface{} // *[N]T
 	Validators   []Validator
 	wasAssigned  bool        // Track if a non-nil value was assigned
+	defaulted    bool        // Track if the default value was applied
 	defaultValue interface{} // [N]T
 	hasDefault   bool
 	transformers []Transformer[interface{}]
@@ -58,6 +59,9 @@ func (f *ArrayField[T]) SetDefaultValue(defaultValue interface{}) {
 
 // Assign assigns a value to the field from the input data
 func (f *ArrayField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
@@ -66,6 +70,7 @@ func (f *ArrayField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 			defaultValue := reflect.ValueOf(f.defaultValue)
 			ptrValue.Elem().Set(defaultValue)
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 
@@ -95,32 +100,55 @@ func (f *ArrayField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 		return fmt.Errorf("expected array type")
 	}
 
-	// Convert source value to slice for easier handling
-	sourceValue := reflect.ValueOf(value)
-	if sourceValue.Kind() != reflect.Slice && sourceValue.Kind() != reflect.Array {
-		return fmt.Errorf("source value must be slice or array")
-	}
+	// Convert the elements into a typed buffer. Decoded JSON ([]interface{}) and typed slices
+	// take a generic path, other sources fall back to reflection.
+	var elements []T
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) != arrayValue.Len() {
+			return fmt.Errorf("array length mismatch: expected %d, got %d", arrayValue.Len(), len(v))
+		}
 
-	// Check length
-	if sourceValue.Len() != arrayValue.Len() {
-		return fmt.Errorf("array length mismatch: expected %d, got %d", arrayValue.Len(), sourceValue.Len())
-	}
+		elements = make([]T, len(v))
+		for i, item := range v {
+			converted, err := convertValue[T](item)
+			if err != nil {
+				return ElementErrors{{Index: i, Error: err}}
+			}
+			elements[i] = converted
+		}
+	case []T:
+		if len(v) != arrayValue.Len() {
+			return fmt.Errorf("array length mismatch: expected %d, got %d", arrayValue.Len(), len(v))
+		}
 
-	// Copy elements
-	for i := 0; i < sourceValue.Len(); i++ {
-		srcElem := sourceValue.Index(i).Interface()
-		converted, err := convertValue[T](srcElem)
-		if err != nil {
-			return fmt.Errorf("element %d: %v", i, err)
+		elements = v
+	default:
+		sourceValue := reflect.ValueOf(value)
+		if sourceValue.Kind() != reflect.Slice && sourceValue.Kind() != reflect.Array {
+			return fmt.Errorf("source value must be slice or array")
+		}
+
+		if sourceValue.Len() != arrayValue.Len() {
+			return fmt.Errorf("array length mismatch: expected %d, got %d", arrayValue.Len(), sourceValue.Len())
+		}
+
+		elements = make([]T, sourceValue.Len())
+		for i := range elements {
+			converted, err := convertValue[T](sourceValue.Index(i).Interface())
+			if err != nil {
+				return ElementErrors{{Index: i, Error: err}}
+			}
+			elements[i] = converted
 		}
-		arrayValue.Index(i).Set(reflect.ValueOf(converted))
 	}
 
+	// The array length is not a type parameter, so the array itself is written with a single reflective copy
+	reflect.Copy(arrayValue, reflect.ValueOf(elements))
+
 	// Apply transformers
-	transformed := arrayValue.Interface()
-	for _, transformer := range f.transformers {
-		var err error
-		transformed, err = transformer.Transform(transformed)
+	if len(f.transformers) > 0 {
+		transformed, err := applyTransformers(f.transformers, arrayValue.Interface(), f.name, schema)
 		if err != nil {
 			return fmt.Errorf("transformer failed: %v", err)
 		}
@@ -133,6 +161,23 @@ func (f *ArrayField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *ArrayField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *ArrayField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ArrayField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *ArrayField[T]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, f.ptr, f.name, schema)
@@ -143,6 +188,23 @@ func (f *ArrayField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *ArrayField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ArrayField[T]) describeField(info *FieldInfo) {
+	info.Type = "[]" + typeName[T]()
+	if ptrType := reflect.TypeOf(f.ptr); ptrType != nil && ptrType.Kind() == reflect.Ptr {
+		info.Type = ptrType.Elem().String()
+	}
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
 // Array creates an array field
 func Array[T any](name string, ptr interface{}, opts ...Option) Field {
 	field := &ArrayField[T]{
@@ -0,0 +1,194 @@
+package poxxy
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// byteSizeUnits holds the multiplier of each size unit, in lowercase:
+// decimal units (kB = 1000 bytes) and binary units (KiB = 1024 bytes)
+var byteSizeUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	"pib": 1 << 50,
+}
+
+// parseByteSize parses a size like "10MB", "512 KiB", "1.5GB" or "1024" into a number of bytes.
+// Units are case-insensitive; decimal units are powers of 1000 and binary units powers of 1024.
+func parseByteSize(str string) (int64, error) {
+	str = strings.TrimSpace(str)
+	end := strings.IndexFunc(str, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	if end < 0 {
+		end = len(str)
+	}
+
+	number := str[:end]
+	multiplier, ok := byteSizeUnits[strings.ToLower(strings.TrimSpace(str[end:]))]
+	if !ok || number == "" {
+		return 0, fmt.Errorf("invalid size %q, expected a size like 10MB or 512KiB", str)
+	}
+
+	if !strings.Contains(number, ".") {
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil || n > math.MaxInt64/multiplier {
+			return 0, fmt.Errorf("size %q is out of range", str)
+		}
+		return n * multiplier, nil
+	}
+
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q, expected a size like 10MB or 512KiB", str)
+	}
+
+	size := math.Round(n * float64(multiplier))
+	if size >= math.MaxInt64 {
+		return 0, fmt.Errorf("size %q is out of range", str)
+	}
+
+	return int64(size), nil
+}
+
+// ByteSizeField represents a size in bytes given in a human-friendly notation ("10MB", "512KiB")
+type ByteSizeField struct {
+	name        string
+	description string
+	ptr         *int64
+	Validators  []Validator
+	wasAssigned bool // Track if a size was assigned
+}
+
+// Name returns the field name
+func (f *ByteSizeField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *ByteSizeField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *ByteSizeField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *ByteSizeField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign assigns a value to the field from the input data.
+// Strings are parsed with their unit; numbers are a number of bytes.
+func (f *ByteSizeField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	if value == nil {
+		return nil
+	}
+
+	var size int64
+	if str, ok := value.(string); ok {
+		n, err := parseByteSize(str)
+		if err != nil {
+			return err
+		}
+		size = n
+	} else {
+		n, _, err := toFloat64(value)
+		if err != nil {
+			return fmt.Errorf("expected a size like 10MB or 512KiB, got %T", value)
+		}
+		if n < 0 || n != math.Trunc(n) || n >= math.MaxInt64 {
+			return fmt.Errorf("invalid size %v, expected a whole number of bytes", value)
+		}
+		size = int64(n)
+	}
+
+	*f.ptr = size
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *ByteSizeField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *ByteSizeField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ByteSizeField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*int64)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the size as an int64 (use int64 bounds with Min and Max);
+// only Required runs when no size was given.
+func (f *ByteSizeField) Validate(schema *Schema) error {
+	if !f.wasAssigned {
+		return validateFieldValidators(requiredValidators(f.Validators), nil, f.name, schema)
+	}
+
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *ByteSizeField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *ByteSizeField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ByteSizeField) describeField(info *FieldInfo) {
+	info.Type = "byte_size"
+}
+
+// ByteSize creates a field parsing human-friendly sizes like "10MB", "512KiB" or "1.5GB" into a number of bytes.
+// Decimal units (kB, MB, GB, TB, PB) are powers of 1000 and binary units (KiB, MiB, GiB, TiB, PiB) powers of 1024;
+// numbers and unitless strings are a number of bytes.
+func ByteSize(name string, ptr *int64, opts ...Option) Field {
+	field := &ByteSizeField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
@@ -0,0 +1,60 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr string
+	}{
+		{"1024", 1024, ""},
+		{"10MB", 10_000_000, ""},
+		{"512KiB", 512 * 1024, ""},
+		{"512 kib", 512 * 1024, ""},
+		{"1.5GB", 1_500_000_000, ""},
+		{"0.5MiB", 512 * 1024, ""},
+		{"42b", 42, ""},
+		{"10XB", 0, `invalid size "10XB", expected a size like 10MB or 512KiB`},
+		{"MB", 0, `invalid size "MB", expected a size like 10MB or 512KiB`},
+		{"-1MB", 0, `invalid size "-1MB", expected a size like 10MB or 512KiB`},
+		{"9000PiB", 0, `size "9000PiB" is out of range`},
+	}
+
+	for _, tt := range tests {
+		got, err := parseByteSize(tt.input)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.input)
+			continue
+		}
+
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+}
+
+func TestByteSize(t *testing.T) {
+	var maxUpload int64
+	schema := NewSchema(
+		ByteSize("max_upload", &maxUpload, WithValidators(Min(int64(1024)), Max(int64(100<<20)))),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"max_upload": "10MB"}))
+	assert.Equal(t, int64(10_000_000), maxUpload)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"max_upload": 2048.0}))
+	assert.Equal(t, int64(2048), maxUpload)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{}))
+
+	err := schema.Apply(map[string]interface{}{"max_upload": "1GiB"})
+	assert.EqualError(t, err, "max_upload: value must be at most 104857600")
+
+	err = schema.Apply(map[string]interface{}{"max_upload": 1.5})
+	assert.EqualError(t, err, "max_upload: invalid size 1.5, expected a whole number of bytes")
+}
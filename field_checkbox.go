@@ -0,0 +1,108 @@
+package poxxy
+
+// CheckboxField represents an HTML checkbox: absent means false, present means true
+type CheckboxField struct {
+	name        string
+	description string
+	ptr         *bool
+	Validators  []Validator
+	defaulted   bool // Track if the field was absent from the input
+}
+
+// Name returns the field name
+func (f *CheckboxField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *CheckboxField) Value() interface{} {
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *CheckboxField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *CheckboxField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign assigns a value to the field from the input data.
+// Browsers only submit checked checkboxes, with any value ("on" by default): every value means true,
+// except JSON booleans which are taken as is and null which means false, like an absent checkbox.
+func (f *CheckboxField) Assign(data map[string]interface{}, schema *Schema) error {
+	value, exists := data[f.name]
+	// A null value (e.g. a JSON body sending "terms": null) is an unchecked box, like a missing key
+	f.defaulted = !exists || value == nil
+	if f.defaulted {
+		*f.ptr = false
+		return nil
+	}
+	schema.SetFieldPresent(f.name)
+
+	if b, ok := value.(bool); ok {
+		*f.ptr = b
+		return nil
+	}
+
+	*f.ptr = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface.
+// A checkbox always has a value; it is defaulted to false when absent.
+func (f *CheckboxField) assignState() (assigned bool, defaulted bool) {
+	return true, f.defaulted
+}
+
+// target implements boundField interface
+func (f *CheckboxField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *CheckboxField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*bool)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators
+func (f *CheckboxField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *CheckboxField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *CheckboxField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *CheckboxField) describeField(info *FieldInfo) {
+	info.Type = "bool"
+	info.HasDefault = true
+	info.Default = false
+}
+
+// Checkbox creates a field following HTML checkbox semantics: the field is false when absent from the input
+// and true when present, whatever its value. Use it instead of a bool Value for form checkboxes,
+// which browsers don't submit when unchecked.
+func Checkbox(name string, ptr *bool, opts ...Option) Field {
+	field := &CheckboxField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
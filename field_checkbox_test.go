@@ -0,0 +1,45 @@
+package poxxy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckboxField(t *testing.T) {
+	var newsletter, terms bool
+	schema := NewSchema(
+		Checkbox("newsletter", &newsletter),
+		Checkbox("terms", &terms, WithValidators(In(true))),
+	)
+
+	t.Run("present with any value", func(t *testing.T) {
+		require.NoError(t, schema.ApplyURLValues(url.Values{"newsletter": {"on"}, "terms": {""}}))
+		assert.True(t, newsletter)
+		assert.True(t, terms)
+		assert.Equal(t, FromInput, schema.Provenance("newsletter"))
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		newsletter = true
+		err := schema.ApplyURLValues(url.Values{})
+		assert.EqualError(t, err, "terms: value false must be one of: [true]")
+		assert.False(t, newsletter)
+		assert.Equal(t, FromDefault, schema.Provenance("newsletter"))
+	})
+
+	t.Run("json booleans", func(t *testing.T) {
+		require.NoError(t, schema.ApplyJSON([]byte(`{"newsletter": false, "terms": true}`)))
+		assert.False(t, newsletter)
+		assert.True(t, terms)
+	})
+
+	t.Run("json null", func(t *testing.T) {
+		newsletter = true
+		require.NoError(t, schema.ApplyJSON([]byte(`{"newsletter": null, "terms": true}`)))
+		assert.False(t, newsletter)
+		assert.Equal(t, FromDefault, schema.Provenance("newsletter"))
+	})
+}
@@ -0,0 +1,126 @@
+package poxxy
+
+// ComputedField represents a field derived from the other fields of the schema
+type ComputedField[T any] struct {
+	name         string
+	description  string
+	ptr          *T
+	compute      func(*Schema) (T, error)
+	Validators   []Validator
+	wasAssigned  bool // Track if the value was computed
+	dependencies []string
+}
+
+// Name returns the field name
+func (f *ComputedField[T]) Name() string {
+	return f.name
+}
+
+// Value returns the computed value of the field
+func (f *ComputedField[T]) Value() interface{} {
+	if f.ptr == nil || !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *ComputedField[T]) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *ComputedField[T]) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign computes the value of the field. The input data is ignored.
+func (f *ComputedField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
+	value, err := f.compute(schema)
+	if err != nil {
+		return err
+	}
+
+	*f.ptr = value
+	f.wasAssigned = true
+	schema.SetFieldPresent(f.name)
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *ComputedField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *ComputedField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ComputedField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*T)
+	return &clone
+}
+
+// Validate validates the computed value using all registered validators
+func (f *ComputedField[T]) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *ComputedField[T]) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *ComputedField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// AppendDependencies implements DependenciesAppender interface
+func (f *ComputedField[T]) AppendDependencies(fieldNames []string) {
+	f.dependencies = append(f.dependencies, fieldNames...)
+}
+
+// GetDependencies implements DependenciesGetter interface
+func (f *ComputedField[T]) GetDependencies() []string {
+	return f.dependencies
+}
+
+// describeField implements fieldDescriber interface
+func (f *ComputedField[T]) describeField(info *FieldInfo) {
+	info.Type = typeName[T]()
+}
+
+// isComputed implements computedField interface
+func (f *ComputedField[T]) isComputed() {}
+
+// Computed creates a field whose value is derived from the other fields of the schema
+// (display names, hashes, totals, ...). The compute function runs after all the other fields
+// are assigned and before validation; the input data is ignored.
+// Computed fields reading other computed fields declare them with After.
+//
+//	poxxy.Computed("full_name", &fullName, func(s *poxxy.Schema) (string, error) {
+//		return firstName + " " + lastName, nil
+//	})
+func Computed[T any](name string, ptr *T, compute func(*Schema) (T, error), opts ...Option) Field {
+	field := &ComputedField[T]{
+		name:    name,
+		ptr:     ptr,
+		compute: compute,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
+
+// ignoresInputKey implements inputlessField interface
+func (f *ComputedField[T]) ignoresInputKey() {}
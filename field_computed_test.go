@@ -0,0 +1,97 @@
+package poxxy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputedField(t *testing.T) {
+	var firstName, lastName, fullName, greeting string
+	var quantity int
+	var unitPrice, total float64
+
+	schema := NewSchema(
+		Computed("greeting", &greeting, func(s *Schema) (string, error) {
+			return "Hello " + fullName, nil
+		}, After("full_name")),
+		Computed("full_name", &fullName, func(s *Schema) (string, error) {
+			return firstName + " " + lastName, nil
+		}, WithValidators(MaxLength(20))),
+		Value("first_name", &firstName, WithTransformers(TrimSpace())),
+		Value("last_name", &lastName),
+		Value("quantity", &quantity, WithDefault(1)),
+		Value("unit_price", &unitPrice),
+		Computed("total", &total, func(s *Schema) (float64, error) {
+			return float64(quantity) * unitPrice, nil
+		}, WithValidators(Max(1000.0))),
+	)
+
+	t.Run("computed after assignments", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{
+			"first_name": " John ",
+			"last_name":  "Doe",
+			"unit_price": 12.5,
+			"full_name":  "ignored",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "John Doe", fullName)
+		assert.Equal(t, "Hello John Doe", greeting)
+		assert.Equal(t, 12.5, total)
+
+		value, ok := schema.GetFieldValue("total")
+		assert.True(t, ok)
+		assert.Equal(t, 12.5, value)
+	})
+
+	t.Run("computed values are validated", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{
+			"first_name": "John",
+			"last_name":  "Doe",
+			"quantity":   100,
+			"unit_price": 12.5,
+		})
+		assert.EqualError(t, err, fmt.Sprintf("total: value must be at most %f", 1000.0))
+	})
+
+	t.Run("required computed field", func(t *testing.T) {
+		var slug string
+		schema := NewSchema(Computed("slug", &slug, func(s *Schema) (string, error) {
+			return "post-1", nil
+		}, WithValidators(Required())))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{}))
+		assert.Equal(t, "post-1", slug)
+	})
+
+	t.Run("field after a computed field", func(t *testing.T) {
+		var name, slug, path string
+		schema := NewSchema(
+			Value("path", &path, WithTransformers(CustomTransformer(func(string) (string, error) {
+				return "/posts/" + slug, nil
+			})), After("slug")),
+			Computed("slug", &slug, func(s *Schema) (string, error) {
+				return strings.ToLower(name), nil
+			}),
+			Value("name", &name),
+		)
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"name": "Hello", "path": "ignored"}))
+		assert.Equal(t, "hello", slug)
+		assert.Equal(t, "/posts/hello", path)
+	})
+
+	t.Run("compute error", func(t *testing.T) {
+		var hash string
+		schema := NewSchema(Computed("hash", &hash, func(s *Schema) (string, error) {
+			return "", errors.New("hashing failed")
+		}))
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{}), "hash: hashing failed")
+	})
+}
@@ -13,8 +13,10 @@ type ConvertField[From, To any] struct {
 	convert      func(From) (*To, error)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue To
 	hasDefault   bool
+	dependencies []string
 	transformers []Transformer[To]
 }
 
@@ -69,14 +71,28 @@ func (f *ConvertField[From, To]) Value() interface{} {
 	return *f.ptr
 }
 
+// AppendDependencies implements DependenciesAppender interface
+func (f *ConvertField[From, To]) AppendDependencies(fieldNames []string) {
+	f.dependencies = append(f.dependencies, fieldNames...)
+}
+
+// GetDependencies implements DependenciesGetter interface
+func (f *ConvertField[From, To]) GetDependencies() []string {
+	return f.dependencies
+}
+
 // Assign assigns a value to the field from the input data
 func (f *ConvertField[From, To]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists {
 		// Apply default value if available
 		if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 		return nil
@@ -113,12 +129,9 @@ func (f *ConvertField[From, To]) Assign(data map[string]interface{}, schema *Sch
 	}
 
 	// Apply transformers
-	transformed := *converted
-	for _, transformer := range f.transformers {
-		transformed, err = transformer.Transform(transformed)
-		if err != nil {
-			return err
-		}
+	transformed, err := applyTransformers(f.transformers, *converted, f.name, schema)
+	if err != nil {
+		return err
 	}
 
 	*f.ptr = transformed
@@ -127,6 +140,23 @@ func (f *ConvertField[From, To]) Assign(data map[string]interface{}, schema *Sch
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *ConvertField[From, To]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *ConvertField[From, To]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ConvertField[From, To]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*To)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *ConvertField[From, To]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
@@ -137,6 +167,20 @@ func (f *ConvertField[From, To]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *ConvertField[From, To]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ConvertField[From, To]) describeField(info *FieldInfo) {
+	info.Type = typeName[To]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
 // Convert creates a conversion field
 func Convert[From, To any](name string, ptr *To, convert func(From) (*To, error), opts ...Option) Field {
 	field := &ConvertField[From, To]{
@@ -175,8 +219,10 @@ type ConvertPointerField[From, To any] struct {
 	convert      func(From) (*To, error)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue To
 	hasDefault   bool
+	dependencies []string
 	transformers []Transformer[To]
 }
 
@@ -230,14 +276,28 @@ func (f *ConvertPointerField[From, To]) Value() interface{} {
 	return *f.ptr
 }
 
+// AppendDependencies implements DependenciesAppender interface
+func (f *ConvertPointerField[From, To]) AppendDependencies(fieldNames []string) {
+	f.dependencies = append(f.dependencies, fieldNames...)
+}
+
+// GetDependencies implements DependenciesGetter interface
+func (f *ConvertPointerField[From, To]) GetDependencies() []string {
+	return f.dependencies
+}
+
 // Assign assigns a value to the field from the input data
 func (f *ConvertPointerField[From, To]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
 		if f.hasDefault {
 			*f.ptr = &f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 
@@ -275,12 +335,9 @@ func (f *ConvertPointerField[From, To]) Assign(data map[string]interface{}, sche
 	}
 
 	// Apply transformers
-	transformed := *converted
-	for _, transformer := range f.transformers {
-		transformed, err = transformer.Transform(transformed)
-		if err != nil {
-			return err
-		}
+	transformed, err := applyTransformers(f.transformers, *converted, f.name, schema)
+	if err != nil {
+		return err
 	}
 
 	*f.ptr = &transformed
@@ -289,6 +346,23 @@ func (f *ConvertPointerField[From, To]) Assign(data map[string]interface{}, sche
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *ConvertPointerField[From, To]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *ConvertPointerField[From, To]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ConvertPointerField[From, To]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(**To)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *ConvertPointerField[From, To]) Validate(schema *Schema) error {
 	if f.ptr == nil || *f.ptr == nil {
@@ -302,3 +376,17 @@ func (f *ConvertPointerField[From, To]) Validate(schema *Schema) error {
 func (f *ConvertPointerField[From, To]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
+
+// GetValidators implements ValidatorsGetter interface
+func (f *ConvertPointerField[From, To]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ConvertPointerField[From, To]) describeField(info *FieldInfo) {
+	info.Type = "*" + typeName[To]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
@@ -0,0 +1,204 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CSVListField represents a slice bound from a separated list like "1,2,3"
+type CSVListField[T any] struct {
+	name         string
+	description  string
+	ptr          *[]T
+	Validators   []Validator
+	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
+	defaultValue []T
+	hasDefault   bool
+	separator    rune
+}
+
+// Name returns the field name
+func (f *CSVListField[T]) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *CSVListField[T]) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *CSVListField[T]) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *CSVListField[T]) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultValue sets the default value for the field
+func (f *CSVListField[T]) SetDefaultValue(defaultValue []T) {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+}
+
+// SetSeparator sets the separator of the list elements
+func (f *CSVListField[T]) SetSeparator(separator rune) {
+	f.separator = separator
+}
+
+// acceptsMultipleValues implements multiValueField interface
+func (f *CSVListField[T]) acceptsMultipleValues() bool {
+	return true
+}
+
+// Assign assigns a value to the field from the input data.
+// Every string value is split on the separator; elements are trimmed and empty elements are skipped.
+func (f *CSVListField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		// Apply default value if available
+		if f.hasDefault {
+			*f.ptr = f.defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		}
+
+		return nil
+	}
+	schema.SetFieldPresent(f.name)
+
+	var items []interface{}
+	switch v := value.(type) {
+	case string:
+		items = f.split(v)
+	case []string:
+		// Repeated keys, e.g. "ids=1,2&ids=3"
+		for _, str := range v {
+			items = append(items, f.split(str)...)
+		}
+	case []interface{}:
+		// Already a list, e.g. a JSON array
+		items = v
+	default:
+		return fmt.Errorf("expected a separated list, got %T", value)
+	}
+
+	result := make([]T, 0, len(items))
+	var errs ElementErrors
+	for i, item := range items {
+		converted, err := convertValue[T](item)
+		if err != nil {
+			errs = append(errs, ElementError{Index: i, Error: err})
+			continue
+		}
+		result = append(result, converted)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	*f.ptr = result
+	f.wasAssigned = true
+	return nil
+}
+
+// split splits a string on the separator of the field
+func (f *CSVListField[T]) split(str string) []interface{} {
+	var items []interface{}
+	for _, item := range strings.Split(str, string(f.separator)) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// assignState implements assignStateReporter interface
+func (f *CSVListField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *CSVListField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *CSVListField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*[]T)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators
+func (f *CSVListField[T]) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *CSVListField[T]) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *CSVListField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *CSVListField[T]) describeField(info *FieldInfo) {
+	info.Type = "[]" + typeName[T]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
+// SeparatorOption holds the separator of a list field
+type SeparatorOption struct {
+	separator rune
+}
+
+// Apply applies the separator to the field
+func (o SeparatorOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetSeparator(rune) }); ok {
+		f.SetSeparator(o.separator)
+	} else {
+		panic(fmt.Sprintf("Separator doesn't support %T", field))
+	}
+}
+
+// Separator sets the separator of a CSVList field (',' by default)
+func Separator(separator rune) Option {
+	return SeparatorOption{separator: separator}
+}
+
+// CSVList creates a slice field bound from a single separated value, like "ids=1,2,3" in a query string.
+// Each element is converted to T; conversion errors are reported per element.
+// Repeated keys are concatenated and JSON arrays are accepted as is.
+func CSVList[T any](name string, ptr *[]T, opts ...Option) Field {
+	field := &CSVListField[T]{
+		name:      name,
+		ptr:       ptr,
+		separator: ',',
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
@@ -0,0 +1,49 @@
+package poxxy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVListField(t *testing.T) {
+	t.Run("query value", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(CSVList("ids", &ids, WithValidators(Each(Min(1)))))
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"ids": {"1, 2,,3"}}))
+		assert.Equal(t, []int{1, 2, 3}, ids)
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"ids": {"1,2", "3"}}))
+		assert.Equal(t, []int{1, 2, 3}, ids)
+	})
+
+	t.Run("conversion and validation errors", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(CSVList("ids", &ids, WithValidators(EachAll(Min(1)))))
+
+		assert.ErrorContains(t, schema.ApplyURLValues(url.Values{"ids": {"1,a"}}), "ids: element 1: ")
+		assert.EqualError(t, schema.ApplyURLValues(url.Values{"ids": {"1,0"}}), "ids: element 1: value must be at least 1")
+	})
+
+	t.Run("separator", func(t *testing.T) {
+		var tags []string
+		schema := NewSchema(CSVList("tags", &tags, Separator('|')))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"tags": "go|rust"}))
+		assert.Equal(t, []string{"go", "rust"}, tags)
+	})
+
+	t.Run("json array and default", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(CSVList("ids", &ids, WithDefault([]int{7})))
+
+		require.NoError(t, schema.ApplyJSON([]byte(`{"ids": [1, 2]}`)))
+		assert.Equal(t, []int{1, 2}, ids)
+
+		require.NoError(t, schema.ApplyJSON([]byte(`{}`)))
+		assert.Equal(t, []int{7}, ids)
+	})
+}
@@ -0,0 +1,124 @@
+package poxxy
+
+import (
+	"fmt"
+	"time"
+)
+
+// DurationField represents a duration given in Go ("90s", "1h30m") or ISO 8601 ("PT1H30M") notation
+type DurationField struct {
+	name        string
+	description string
+	ptr         *time.Duration
+	Validators  []Validator
+	wasAssigned bool // Track if a duration was assigned
+}
+
+// Name returns the field name
+func (f *DurationField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *DurationField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *DurationField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *DurationField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign assigns a value to the field from the input data
+func (f *DurationField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case time.Duration:
+		*f.ptr = v
+	case string:
+		d, err := parseDuration(v)
+		if err != nil {
+			return err
+		}
+		*f.ptr = d
+	default:
+		return fmt.Errorf("expected a duration like 90s or PT1H30M, got %T", value)
+	}
+
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *DurationField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *DurationField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *DurationField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*time.Duration)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the value as a time.Duration; only Required runs when no duration was given.
+func (f *DurationField) Validate(schema *Schema) error {
+	if !f.wasAssigned {
+		return validateFieldValidators(requiredValidators(f.Validators), nil, f.name, schema)
+	}
+
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *DurationField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *DurationField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *DurationField) describeField(info *FieldInfo) {
+	info.Type = "duration"
+}
+
+// DurationRange creates a duration field bounded by the given validators, typically Min and Max:
+//
+//	poxxy.DurationRange("window", &window, poxxy.Min(time.Minute), poxxy.Max(24*time.Hour))
+//
+// The duration is given in Go ("90s", "1h30m") or ISO 8601 ("PT1H30M", "P1D") notation.
+// ISO 8601 days are 24 hours and weeks 7 days; years and months are rejected as their length varies.
+func DurationRange(name string, ptr *time.Duration, validators ...Validator) Field {
+	return &DurationField{
+		name:       name,
+		ptr:        ptr,
+		Validators: validators,
+	}
+}
@@ -0,0 +1,75 @@
+package poxxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr string
+	}{
+		{"90s", 90 * time.Second, ""},
+		{"1h30m", 90 * time.Minute, ""},
+		{"PT1H30M", 90 * time.Minute, ""},
+		{"P1DT2H", 26 * time.Hour, ""},
+		{"P2W", 14 * 24 * time.Hour, ""},
+		{"pt0.5s", 500 * time.Millisecond, ""},
+		{"PT1,5M", 90 * time.Second, ""},
+		{"-PT10S", -10 * time.Second, ""},
+		{"P1Y", 0, `invalid ISO 8601 duration "P1Y": years and months are not supported`},
+		{"P1M", 0, `invalid ISO 8601 duration "P1M": years and months are not supported`},
+		{"PT", 0, `invalid ISO 8601 duration "PT"`},
+		{"P1H", 0, `invalid ISO 8601 duration "P1H"`},
+		{"PT1M1H", 0, `invalid ISO 8601 duration "PT1M1H"`},
+		{"PT1H1H", 0, `invalid ISO 8601 duration "PT1H1H"`},
+		{"P1D2W", 0, `invalid ISO 8601 duration "P1D2W"`},
+		{"P1W2DT3H4M5S", (7+2)*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second, ""},
+		{"PT2562047H47M16S", 2562047*time.Hour + 47*time.Minute + 16*time.Second, ""},
+		{"PT2562047H47M16.854775808S", 0, `invalid ISO 8601 duration "PT2562047H47M16.854775808S": out of range`},
+		{"-PT2562047H47M16.854775808S", 0, `invalid ISO 8601 duration "-PT2562047H47M16.854775808S": out of range`},
+		{"soon", 0, `invalid duration "soon", expected a duration like 90s or PT1H30M`},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDuration(tt.input)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.input)
+			continue
+		}
+
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+}
+
+func TestDurationRange(t *testing.T) {
+	var window time.Duration
+	schema := NewSchema(DurationRange("window", &window, Min(time.Minute), Max(24*time.Hour)))
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"window": "PT1H30M"}))
+	assert.Equal(t, 90*time.Minute, window)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"window": "15m"}))
+	assert.Equal(t, 15*time.Minute, window)
+
+	// Bounds only apply to a given duration
+	assert.NoError(t, schema.Apply(map[string]interface{}{}))
+
+	err := schema.Apply(map[string]interface{}{"window": "30s"})
+	assert.EqualError(t, err, "window: value must be at least 1m0s")
+
+	err = schema.Apply(map[string]interface{}{"window": "P2D"})
+	assert.EqualError(t, err, "window: value must be at most 24h0m0s")
+
+	err = schema.Apply(map[string]interface{}{"window": 60})
+	assert.EqualError(t, err, "window: expected a duration like 90s or PT1H30M, got int")
+
+	schema = NewSchema(DurationRange("window", &window, Required()))
+	assert.EqualError(t, schema.Apply(map[string]interface{}{}), "window: field is required")
+}
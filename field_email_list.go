@@ -0,0 +1,221 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EmailListField represents a list of email addresses, e.g. the recipients of an invitation
+type EmailListField struct {
+	name         string
+	description  string
+	ptr          *[]string
+	Validators   []Validator
+	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
+	defaultValue []string
+	hasDefault   bool
+	maxElements  int // Maximum number of addresses accepted, 0 means unlimited
+	email        Validator
+}
+
+// Name returns the field name
+func (f *EmailListField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *EmailListField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *EmailListField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *EmailListField) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultValue sets the default value for the field
+func (f *EmailListField) SetDefaultValue(defaultValue []string) {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+}
+
+// SetMaxElements sets the maximum number of addresses accepted by the field
+func (f *EmailListField) SetMaxElements(n int) {
+	f.maxElements = n
+}
+
+// acceptsMultipleValues implements multiValueField interface
+func (f *EmailListField) acceptsMultipleValues() bool {
+	return true
+}
+
+// Assign assigns a value to the field from the input data.
+// Strings are split on commas and semicolons; addresses are trimmed, their domain is lowercased
+// and empty elements are skipped. The elements of a JSON array must each hold a single address.
+func (f *EmailListField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		// Apply default value if available
+		if f.hasDefault {
+			*f.ptr = f.defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		}
+
+		return nil
+	}
+	schema.SetFieldPresent(f.name)
+
+	var addresses []string
+	switch v := value.(type) {
+	case string:
+		addresses = splitEmails(v)
+	case []string:
+		// Repeated keys, e.g. "to=a@example.com&to=b@example.com"
+		for _, str := range v {
+			addresses = append(addresses, splitEmails(str)...)
+		}
+	case []interface{}:
+		// JSON array: an element is an address, for the errors to be reported at its index
+		for i, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return ElementErrors{{Index: i, Error: fmt.Errorf("expected an email address, got %T", item)}}
+			}
+			address := strings.TrimSpace(str)
+			if address == "" || strings.ContainsAny(address, ",;") {
+				return ElementErrors{{Index: i, Error: fmt.Errorf("expected a single email address, got %q", str)}}
+			}
+			addresses = append(addresses, address)
+		}
+	default:
+		return fmt.Errorf("expected a list of email addresses, got %T", value)
+	}
+
+	if f.maxElements > 0 {
+		if err := checkMaxElements(len(addresses), f.maxElements); err != nil {
+			return err
+		}
+	}
+
+	for i, address := range addresses {
+		addresses[i] = normalizeEmail(address)
+	}
+
+	*f.ptr = addresses
+	f.wasAssigned = true
+	return nil
+}
+
+// splitEmails splits a string on commas and semicolons, skipping empty elements
+func splitEmails(str string) []string {
+	var addresses []string
+	for _, address := range strings.FieldsFunc(str, func(r rune) bool { return r == ',' || r == ';' }) {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+// normalizeEmail lowercases the domain of an address; the local part is case-sensitive and kept as is
+func normalizeEmail(address string) string {
+	i := strings.LastIndexByte(address, '@')
+	if i < 0 {
+		return address
+	}
+
+	return address[:i+1] + strings.ToLower(address[i+1:])
+}
+
+// assignState implements assignStateReporter interface
+func (f *EmailListField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *EmailListField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *EmailListField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*[]string)
+	return &clone
+}
+
+// Validate validates every address, reporting all the invalid ones with their index,
+// then runs the validators of the field
+func (f *EmailListField) Validate(schema *Schema) error {
+	if f.wasAssigned {
+		var errs ElementErrors
+		for i, address := range *f.ptr {
+			if err := f.email.Validate(address, f.name); err != nil {
+				errs = append(errs, ElementError{Index: i, Error: err})
+			}
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *EmailListField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *EmailListField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *EmailListField) describeField(info *FieldInfo) {
+	info.Type = "[]string"
+	each := ConstraintInfo{Kind: "each", Params: map[string]interface{}{"validators": describeValidators([]Validator{f.email})}}
+	info.Constraints = append([]ConstraintInfo{each}, info.Constraints...)
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
+// EmailList creates a field binding a list of email addresses, for invite or share endpoints.
+// It accepts a JSON array of addresses or a comma or semicolon separated string ("a@example.com; b@example.com").
+// Every address is validated like Email and all the invalid ones are reported with their index.
+// Use WithMaxElements to limit the number of addresses:
+//
+//	poxxy.EmailList("recipients", &recipients, poxxy.WithMaxElements(50), poxxy.WithValidators(poxxy.Unique()))
+func EmailList(name string, ptr *[]string, opts ...Option) Field {
+	field := &EmailListField{
+		name:  name,
+		ptr:   ptr,
+		email: Email(),
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
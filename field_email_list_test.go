@@ -0,0 +1,68 @@
+package poxxy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailListField(t *testing.T) {
+	t.Run("separated string", func(t *testing.T) {
+		var recipients []string
+		schema := NewSchema(EmailList("recipients", &recipients))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"recipients": " Jane@Example.COM, bob@example.org;;carol@example.net; "}))
+		assert.Equal(t, []string{"Jane@example.com", "bob@example.org", "carol@example.net"}, recipients)
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"recipients": {"a@example.com,b@example.com", "c@example.com"}}))
+		assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com"}, recipients)
+	})
+
+	t.Run("json array", func(t *testing.T) {
+		var recipients []string
+		schema := NewSchema(EmailList("recipients", &recipients))
+
+		require.NoError(t, schema.ApplyJSON([]byte(`{"recipients": ["a@example.com", " b@EXAMPLE.com "]}`)))
+		assert.Equal(t, []string{"a@example.com", "b@example.com"}, recipients)
+
+		assert.EqualError(t, schema.ApplyJSON([]byte(`{"recipients": ["a@example.com", 42]}`)), "recipients: element 1: expected an email address, got float64")
+		assert.EqualError(t, schema.ApplyJSON([]byte(`{"recipients": ["a@example.com, b@example.com", "nope"]}`)), `recipients: element 0: expected a single email address, got "a@example.com, b@example.com"`)
+
+		err := schema.ApplyJSON([]byte(`{"recipients": ["a@example.com", "nope", "b@example.com"]}`))
+		require.Error(t, err)
+		assert.Equal(t, ElementErrors{{Index: 1, Error: newValidationError(CodeEmail)}}, err.(Errors)[0].Error)
+	})
+
+	t.Run("every invalid address is reported", func(t *testing.T) {
+		var recipients []string
+		schema := NewSchema(EmailList("recipients", &recipients, WithValidators(Required())))
+
+		err := schema.Apply(map[string]interface{}{"recipients": "a@example.com, nope, b@example.com, @example.com"})
+		require.Error(t, err)
+		assert.Equal(t, ElementErrors{
+			{Index: 1, Error: newValidationError(CodeEmail)},
+			{Index: 3, Error: newValidationError(CodeEmail)},
+		}, err.(Errors)[0].Error)
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{}), "recipients: field is required")
+	})
+
+	t.Run("max elements", func(t *testing.T) {
+		var recipients []string
+		schema := NewSchema(EmailList("recipients", &recipients, WithMaxElements(2)))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"recipients": "a@example.com, b@example.com"}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"recipients": "a@example.com, b@example.com, c@example.com"}), "recipients: must have at most 2 items")
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		var recipients []string
+		schema := NewSchema(EmailList("recipients", &recipients, WithValidators(MaxLength(50))))
+
+		info := schema.Describe()[0]
+		assert.Equal(t, "[]string", info.Type)
+		assert.Equal(t, "each(email), max_length=50", formatConstraints(info.Constraints))
+	})
+}
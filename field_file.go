@@ -0,0 +1,443 @@
+package poxxy
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF header decoder
+	_ "image/jpeg" // Register the JPEG header decoder
+	_ "image/png"  // Register the PNG header decoder
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// UploadedFile describes a file received by ApplyMultipart
+type UploadedFile struct {
+	// Filename is the name of the file given by the client
+	Filename string
+	// ContentType is the MIME type detected from the content of the file, not the one declared by the client
+	ContentType string
+	// Size is the size of the file in bytes
+	Size int64
+	// Path is the path of the temporary file holding the content, when no FileSink is set.
+	// The caller is responsible for removing it.
+	Path string
+	// ImageFormat, Width and Height describe PNG, JPEG and GIF images, read from their header.
+	// ImageFormat is empty for other files.
+	ImageFormat string
+	Width       int
+	Height      int
+}
+
+// sniffSize is the number of bytes read ahead to sniff the content type of files
+const sniffSize = 512
+
+// maxImageHeaderSize is the maximum number of bytes read to decode the header of images.
+// JPEG files may hold several metadata segments (EXIF, ICC profiles, XMP) of up to 64 KiB each before their dimensions.
+const maxImageHeaderSize = 4 << 20
+
+// FileSink returns the writer receiving the content of an uploaded file.
+// If the upload is rejected while streaming (e.g. too large), the writer has received a partial content:
+// the sink is responsible for discarding it. Writers implementing io.Closer are closed once the upload ends.
+type FileSink func(filename string) (io.Writer, error)
+
+// FileField represents a file uploaded in a multipart request
+type FileField struct {
+	name         string
+	description  string
+	ptr          *UploadedFile
+	Validators   []Validator
+	wasAssigned  bool // Track if a file was assigned
+	maxSize      int64
+	allowedTypes []string
+	sink         FileSink
+}
+
+// fileUpload holds the result of streaming a file part, as given to FileField.Assign
+type fileUpload struct {
+	file *UploadedFile
+	err  error
+}
+
+// Name returns the field name
+func (f *FileField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *FileField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *FileField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *FileField) SetDescription(description string) {
+	f.description = description
+}
+
+// SetMaxFileSize sets the maximum size of the file in bytes
+func (f *FileField) SetMaxFileSize(size int64) {
+	f.maxSize = size
+}
+
+// SetAllowedMIMETypes sets the MIME types accepted by the field
+func (f *FileField) SetAllowedMIMETypes(types []string) {
+	f.allowedTypes = types
+}
+
+// SetFileSink sets the writer receiving the content of the file
+func (f *FileField) SetFileSink(sink FileSink) {
+	f.sink = sink
+}
+
+// Assign assigns the file streamed by ApplyMultipart to the field
+func (f *FileField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
+	value, exists := data[f.name]
+	if !exists || value == nil {
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	upload, ok := value.(fileUpload)
+	if !ok {
+		return fmt.Errorf("expected an uploaded file, got %T", value)
+	}
+
+	if upload.err != nil {
+		return upload.err
+	}
+
+	*f.ptr = *upload.file
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *FileField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *FileField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *FileField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*UploadedFile)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the value as an UploadedFile.
+func (f *FileField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, f.Value(), f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *FileField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *FileField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *FileField) describeField(info *FieldInfo) {
+	info.Type = "file"
+}
+
+// receive streams a file part to the sink, enforcing the size and MIME type while reading
+func (f *FileField) receive(part *multipart.Part) (*UploadedFile, error) {
+	// Peek the first bytes to sniff the content type
+	src := bufio.NewReaderSize(part, sniffSize)
+	head, err := src.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	file := &UploadedFile{Filename: part.FileName(), ContentType: http.DetectContentType(head)}
+	if len(f.allowedTypes) > 0 && !mimeTypeAllowed(file.ContentType, f.allowedTypes) {
+		mediaType, _, _ := mime.ParseMediaType(file.ContentType)
+		return nil, newValidationError(CodeMIMEType, "type", mediaType)
+	}
+
+	var body io.Reader = src
+	if strings.HasPrefix(file.ContentType, "image/") {
+		// Only the header is decoded, never the pixels: the bytes read by the decoder are kept to be stored
+		var header bytes.Buffer
+		if config, format, err := image.DecodeConfig(io.TeeReader(io.LimitReader(src, maxImageHeaderSize), &header)); err == nil {
+			file.ImageFormat, file.Width, file.Height = format, config.Width, config.Height
+		}
+		body = io.MultiReader(&header, src)
+	}
+
+	sink := f.sink
+	if sink == nil {
+		sink = func(string) (io.Writer, error) {
+			tmp, err := os.CreateTemp("", "poxxy-upload-*")
+			if err != nil {
+				return nil, err
+			}
+			file.Path = tmp.Name()
+			return tmp, nil
+		}
+	}
+
+	w, err := sink(file.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	size, err := f.copy(w, body)
+	if closer, ok := w.(io.Closer); ok {
+		if closeErr := closer.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to store file: %w", closeErr)
+		}
+	}
+
+	if err != nil {
+		if file.Path != "" {
+			os.Remove(file.Path)
+		}
+		return nil, err
+	}
+
+	file.Size = size
+	return file, nil
+}
+
+// copy writes the part to w, stopping as soon as the maximum size is exceeded
+func (f *FileField) copy(w io.Writer, src io.Reader) (int64, error) {
+	if f.maxSize > 0 {
+		src = io.LimitReader(src, f.maxSize+1)
+	}
+
+	size, err := io.Copy(w, src)
+	if err != nil {
+		return size, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	if f.maxSize > 0 && size > f.maxSize {
+		return size, newValidationError(CodeMaxFileSize, "max", strconv.FormatInt(f.maxSize, 10))
+	}
+
+	return size, nil
+}
+
+// mimeTypeAllowed reports whether a content type matches one of the allowed types (e.g. "image/png" or "image/*")
+func mimeTypeAllowed(contentType string, allowed []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, a := range allowed {
+		if a == mediaType {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// File creates a file field receiving a file uploaded in a multipart request (see ApplyMultipart)
+func File(name string, ptr *UploadedFile, opts ...Option) Field {
+	field := &FileField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
+
+// MaxFileSizeOption holds the maximum size of a file field
+type MaxFileSizeOption struct {
+	size int64
+}
+
+// Apply applies the maximum size to the field
+func (o MaxFileSizeOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetMaxFileSize(int64) }); ok {
+		f.SetMaxFileSize(o.size)
+	} else {
+		panic(fmt.Sprintf("MaxFileSize doesn't support %T", field))
+	}
+}
+
+// MaxFileSize rejects files larger than size bytes.
+// The size is checked while streaming: the upload stops as soon as the limit is exceeded.
+func MaxFileSize(size int64) Option {
+	return MaxFileSizeOption{size: size}
+}
+
+// AllowedMIMETypesOption holds the MIME types accepted by a file field
+type AllowedMIMETypesOption struct {
+	types []string
+}
+
+// Apply applies the MIME types to the field
+func (o AllowedMIMETypesOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetAllowedMIMETypes([]string) }); ok {
+		f.SetAllowedMIMETypes(o.types)
+	} else {
+		panic(fmt.Sprintf("AllowedMIMETypes doesn't support %T", field))
+	}
+}
+
+// AllowedMIMETypes rejects files whose content doesn't match one of the given MIME types.
+// Types like "image/*" match every subtype. The type is detected from the first bytes of the file,
+// before anything is written to the sink.
+func AllowedMIMETypes(types ...string) Option {
+	return AllowedMIMETypesOption{types: types}
+}
+
+// FileSinkOption holds the sink of a file field
+type FileSinkOption struct {
+	sink FileSink
+}
+
+// Apply applies the sink to the field
+func (o FileSinkOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetFileSink(FileSink) }); ok {
+		f.SetFileSink(o.sink)
+	} else {
+		panic(fmt.Sprintf("WithFileSink doesn't support %T", field))
+	}
+}
+
+// WithFileSink streams the content of a file field to the writer returned by sink
+// (e.g. an object storage upload) instead of a temporary file
+func WithFileSink(sink FileSink) Option {
+	return FileSinkOption{sink: sink}
+}
+
+// ApplyMultipart assigns the data of a multipart/form-data request to a schema.
+// Unlike r.ParseMultipartForm, the body is streamed: files are written to their sink as they are read,
+// with their size and MIME type checked on the fly, so large uploads are never buffered in memory.
+// File parts without a matching File field are skipped.
+func (s *Schema) ApplyMultipart(r *http.Request, options ...SchemaOption) error {
+	data, err := s.multipartData(r)
+	if err != nil {
+		return err
+	}
+
+	return s.Apply(data, append([]SchemaOption{WithRequestHeader(r.Header)}, options...)...)
+}
+
+// multipartData streams the parts of a multipart/form-data request, returning the data given to Apply
+func (s *Schema) multipartData(r *http.Request) (map[string]interface{}, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multipart form: %w", err)
+	}
+
+	values := url.Values{}
+	uploads := map[string]interface{}{}
+
+	// The temporary files received so far are removed when the form can't be read,
+	// as their paths never reach the caller
+	received := false
+	defer func() {
+		if received {
+			return
+		}
+		for _, upload := range uploads {
+			if upload, ok := upload.(fileUpload); ok && upload.file != nil && upload.file.Path != "" {
+				os.Remove(upload.file.Path)
+			}
+		}
+	}()
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multipart form: %w", err)
+		}
+
+		name := part.FormName()
+		if name == "" {
+			continue
+		}
+
+		if part.FileName() == "" {
+			value, err := io.ReadAll(io.LimitReader(part, MaxBodySize+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read multipart form: %w", err)
+			}
+			if int64(len(value)) > MaxBodySize {
+				return nil, fmt.Errorf("failed to read multipart form: value of %q is too large", name)
+			}
+
+			values.Add(name, string(value))
+			continue
+		}
+
+		field, ok := s.fileField(name)
+		if !ok {
+			continue
+		}
+
+		if previous, seen := uploads[name].(fileUpload); seen {
+			if previous.file != nil && previous.file.Path != "" {
+				os.Remove(previous.file.Path)
+			}
+			uploads[name] = fileUpload{err: fmt.Errorf("expected a single file")}
+			continue
+		}
+
+		file, err := field.receive(part)
+		uploads[name] = fileUpload{file: file, err: err}
+	}
+	received = true
+
+	data := s.urlValuesData(values)
+	for name, upload := range uploads {
+		data[name] = upload
+	}
+
+	return data, nil
+}
+
+// fileField returns the file field with the given name
+func (s *Schema) fileField(name string) (*FileField, bool) {
+	for _, field := range s.fields {
+		if f, ok := field.(*FileField); ok && f.name == name {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,153 @@
+package poxxy
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is the signature of a PNG file, enough for content sniffing
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+// newMultipartRequest creates a multipart request with the given values and files
+func newMultipartRequest(t *testing.T, values map[string]string, files map[string][]byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range values {
+		require.NoError(t, writer.WriteField(name, value))
+	}
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name+".bin")
+		require.NoError(t, err)
+		_, err = part.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return r
+}
+
+func TestSchema_ApplyMultipart(t *testing.T) {
+	avatarContent := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0}, 100)...)
+
+	t.Run("values and temporary file", func(t *testing.T) {
+		var name string
+		var avatar UploadedFile
+		schema := NewSchema(
+			Value("name", &name),
+			File("avatar", &avatar, WithValidators(Required()), AllowedMIMETypes("image/*")),
+		)
+
+		r := newMultipartRequest(t, map[string]string{"name": "John"}, map[string][]byte{"avatar": avatarContent})
+		require.NoError(t, schema.ApplyMultipart(r))
+		t.Cleanup(func() { os.Remove(avatar.Path) })
+
+		assert.Equal(t, "John", name)
+		assert.Equal(t, "avatar.bin", avatar.Filename)
+		assert.Equal(t, "image/png", avatar.ContentType)
+		assert.Equal(t, int64(len(avatarContent)), avatar.Size)
+
+		stored, err := os.ReadFile(avatar.Path)
+		require.NoError(t, err)
+		assert.Equal(t, avatarContent, stored)
+	})
+
+	t.Run("custom sink", func(t *testing.T) {
+		var avatar UploadedFile
+		var sink bytes.Buffer
+		schema := NewSchema(File("avatar", &avatar, WithFileSink(func(filename string) (io.Writer, error) {
+			return &sink, nil
+		})))
+
+		r := newMultipartRequest(t, nil, map[string][]byte{"avatar": avatarContent})
+		require.NoError(t, schema.ApplyMultipart(r))
+		assert.Empty(t, avatar.Path)
+		assert.Equal(t, avatarContent, sink.Bytes())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		var avatar UploadedFile
+		var sink bytes.Buffer
+		schema := NewSchema(File("avatar", &avatar, MaxFileSize(64), WithFileSink(func(filename string) (io.Writer, error) {
+			return &sink, nil
+		})))
+
+		r := newMultipartRequest(t, nil, map[string][]byte{"avatar": avatarContent})
+		assert.EqualError(t, schema.ApplyMultipart(r), "avatar: file must be at most 64 bytes")
+		assert.Equal(t, 65, sink.Len(), "streaming stops right after the limit")
+	})
+
+	t.Run("disallowed type is rejected before writing", func(t *testing.T) {
+		var avatar UploadedFile
+		written := false
+		schema := NewSchema(File("avatar", &avatar, AllowedMIMETypes("image/png", "image/jpeg"), WithFileSink(func(filename string) (io.Writer, error) {
+			written = true
+			return io.Discard, nil
+		})))
+
+		r := newMultipartRequest(t, nil, map[string][]byte{"avatar": []byte("plain text")})
+		assert.EqualError(t, schema.ApplyMultipart(r), "avatar: file type text/plain is not allowed")
+		assert.False(t, written)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		var avatar UploadedFile
+		schema := NewSchema(File("avatar", &avatar, WithValidators(Required())))
+
+		r := newMultipartRequest(t, map[string]string{"name": "John"}, nil)
+		assert.EqualError(t, schema.ApplyMultipart(r), "avatar: field is required")
+	})
+
+	t.Run("through ApplyHTTPRequest", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name))
+
+		r := newMultipartRequest(t, map[string]string{"name": "John"}, map[string][]byte{"ignored": []byte("x")})
+		require.NoError(t, schema.ApplyHTTPRequest(httptest.NewRecorder(), r, nil))
+		assert.Equal(t, "John", name)
+	})
+
+	t.Run("temporary files are removed when the form can't be read", func(t *testing.T) {
+		tmp := t.TempDir()
+		t.Setenv("TMPDIR", tmp)
+
+		var avatar UploadedFile
+		schema := NewSchema(File("avatar", &avatar))
+
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("avatar", "avatar.png")
+		require.NoError(t, err)
+		_, err = part.Write(avatarContent)
+		require.NoError(t, err)
+		// The body ends in the middle of the next part
+		_, err = writer.CreateFormField("name")
+		require.NoError(t, err)
+
+		r := httptest.NewRequest(http.MethodPost, "/", &body)
+		r.Header.Set("Content-Type", writer.FormDataContentType())
+		assert.ErrorContains(t, schema.ApplyMultipart(r), "failed to read multipart form")
+
+		entries, err := os.ReadDir(tmp)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("not multipart", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("name=John"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		assert.Error(t, NewSchema().ApplyMultipart(r))
+	})
+}
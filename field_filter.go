@@ -0,0 +1,326 @@
+package poxxy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FilterOperator identifies the comparison of a filter condition
+type FilterOperator string
+
+// Filter operators, used as the last bracket of a filter key (e.g. "filter[age][gte]")
+const (
+	OpEq       FilterOperator = "eq"
+	OpNe       FilterOperator = "ne"
+	OpGt       FilterOperator = "gt"
+	OpGte      FilterOperator = "gte"
+	OpLt       FilterOperator = "lt"
+	OpLte      FilterOperator = "lte"
+	OpIn       FilterOperator = "in"
+	OpContains FilterOperator = "contains"
+)
+
+// FilterCondition is a condition of a filter, e.g. age >= 18
+type FilterCondition struct {
+	Field    string
+	Operator FilterOperator
+	// Value is converted to the type declared with Filterable; for OpIn, it is a []interface{} of converted values
+	Value interface{}
+}
+
+// Filter holds the conditions parsed by a Filters field, which must all match
+type Filter struct {
+	Conditions []FilterCondition
+}
+
+// Get returns the conditions on a field
+func (f Filter) Get(field string) []FilterCondition {
+	var conditions []FilterCondition
+	for _, condition := range f.Conditions {
+		if condition.Field == field {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return conditions
+}
+
+// FilterableField declares a field that can be filtered on, with its allowed operators.
+// It is an option of Filters.
+type FilterableField struct {
+	name      string
+	operators []FilterOperator
+	convert   func(value interface{}) (interface{}, error)
+}
+
+// Filterable declares a field of type T that can be filtered on with the given operators (OpEq if none).
+// Values are converted to T; OpIn values are comma-separated lists whose elements are converted to T.
+func Filterable[T any](name string, operators ...FilterOperator) FilterableField {
+	if len(operators) == 0 {
+		operators = []FilterOperator{OpEq}
+	}
+
+	return FilterableField{
+		name:      name,
+		operators: operators,
+		convert: func(value interface{}) (interface{}, error) {
+			return convertValue[T](value)
+		},
+	}
+}
+
+// Apply adds the filterable field to a Filters field
+func (f FilterableField) Apply(field interface{}) {
+	if filter, ok := field.(*FilterField); ok {
+		filter.fields[f.name] = f
+	} else {
+		panic(fmt.Sprintf("Filterable doesn't support %T", field))
+	}
+}
+
+// allows reports whether an operator is allowed on the field
+func (f FilterableField) allows(operator FilterOperator) bool {
+	for _, allowed := range f.operators {
+		if allowed == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// condition converts a raw value into a condition on the field
+func (f FilterableField) condition(operator FilterOperator, raw interface{}) (FilterCondition, error) {
+	if !f.allows(operator) {
+		return FilterCondition{}, fmt.Errorf("operator %q is not allowed", operator)
+	}
+
+	condition := FilterCondition{Field: f.name, Operator: operator}
+	if operator != OpIn {
+		value, err := f.convert(raw)
+		if err != nil {
+			return FilterCondition{}, err
+		}
+		condition.Value = value
+		return condition, nil
+	}
+
+	var items []interface{}
+	switch v := raw.(type) {
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+	case []interface{}:
+		items = v
+	default:
+		items = []interface{}{raw}
+	}
+
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		value, err := f.convert(item)
+		if err != nil {
+			return FilterCondition{}, fmt.Errorf("element %d: %v", i, err)
+		}
+		values[i] = value
+	}
+	condition.Value = values
+
+	return condition, nil
+}
+
+// FilterField represents a structured filter like "filter[status]=active&filter[age][gte]=18"
+type FilterField struct {
+	name        string
+	description string
+	ptr         *Filter
+	fields      map[string]FilterableField
+	Validators  []Validator
+	wasAssigned bool // Track if a condition was assigned
+}
+
+// Name returns the field name
+func (f *FilterField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *FilterField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *FilterField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *FilterField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign parses the conditions from the input data.
+// They are read from bracketed keys (e.g. "filter[age][gte]", as in query strings) or from an object
+// (e.g. {"filter": {"age": {"gte": 18}}}, as in JSON bodies). A key without operator uses OpEq.
+func (f *FilterField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	*f.ptr = Filter{}
+
+	raw := map[string]map[FilterOperator]interface{}{}
+	var errs Errors
+	add := func(field string, operator FilterOperator, value interface{}) {
+		// Repeated query keys, e.g. "filter[id][in]=1&filter[id][in]=2": only OpIn takes several values
+		if values, ok := value.([]string); ok && len(values) > 1 {
+			if operator != OpIn {
+				errs = append(errs, FieldError{Field: field, Error: fmt.Errorf("operator %q takes a single value", operator)})
+				return
+			}
+			var items []interface{}
+			for _, v := range values {
+				for _, item := range strings.Split(v, ",") {
+					items = append(items, strings.TrimSpace(item))
+				}
+			}
+			value = items
+		} else if ok && len(values) == 1 {
+			value = values[0]
+		}
+		if raw[field] == nil {
+			raw[field] = map[FilterOperator]interface{}{}
+		}
+		raw[field][operator] = value
+	}
+
+	if obj, ok := data[f.name].(map[string]interface{}); ok {
+		for field, value := range obj {
+			if operators, ok := value.(map[string]interface{}); ok {
+				for operator, v := range operators {
+					add(field, FilterOperator(operator), v)
+				}
+				continue
+			}
+			add(field, OpEq, value)
+		}
+	}
+
+	prefix := f.name + "["
+	for key, value := range data {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+
+		// rest is "status]" or "age][gte]"
+		field, operator, _ := strings.Cut(strings.TrimSuffix(rest, "]"), "][")
+		if operator == "" {
+			operator = string(OpEq)
+		}
+		add(field, FilterOperator(operator), value)
+	}
+
+	if len(raw) == 0 && len(errs) == 0 {
+		return nil
+	}
+	schema.SetFieldPresent(f.name)
+
+	var conditions []FilterCondition
+	for field, operators := range raw {
+		filterable, ok := f.fields[field]
+		if !ok {
+			errs = append(errs, FieldError{Field: field, Error: fmt.Errorf("field is not filterable")})
+			continue
+		}
+
+		for operator, value := range operators {
+			condition, err := filterable.condition(operator, value)
+			if err != nil {
+				errs = append(errs, FieldError{Field: field, Error: err})
+				continue
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			if errs[i].Field != errs[j].Field {
+				return errs[i].Field < errs[j].Field
+			}
+			return errs[i].Error.Error() < errs[j].Error.Error()
+		})
+		return errs
+	}
+
+	sort.Slice(conditions, func(i, j int) bool {
+		if conditions[i].Field != conditions[j].Field {
+			return conditions[i].Field < conditions[j].Field
+		}
+		return conditions[i].Operator < conditions[j].Operator
+	})
+
+	*f.ptr = Filter{Conditions: conditions}
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *FilterField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *FilterField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *FilterField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*Filter)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the value as a Filter.
+func (f *FilterField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, f.Value(), f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *FilterField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *FilterField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *FilterField) describeField(info *FieldInfo) {
+	info.Type = "filter"
+}
+
+// Filters creates a field parsing structured filters like "filter[status]=active&filter[age][gte]=18"
+// into a Filter. Only the fields and operators declared with Filterable are accepted; values are converted
+// to the declared types. The values of a repeated OpIn key are merged ("filter[id][in]=1,2&filter[id][in]=3"),
+// other operators take a single value.
+func Filters(name string, ptr *Filter, opts ...Option) Field {
+	field := &FilterField{
+		name:   name,
+		ptr:    ptr,
+		fields: make(map[string]FilterableField),
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
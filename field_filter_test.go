@@ -0,0 +1,63 @@
+package poxxy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterField(t *testing.T) {
+	var filter Filter
+	schema := NewSchema(Filters("filter", &filter,
+		Filterable[string]("status", OpEq, OpIn),
+		Filterable[int]("age", OpGte, OpLte),
+	))
+
+	t.Run("query string", func(t *testing.T) {
+		values := url.Values{"filter[status]": {"active"}, "filter[age][gte]": {"18"}, "filter[age][lte]": {"65"}}
+		require.NoError(t, schema.ApplyURLValues(values))
+
+		assert.Equal(t, []FilterCondition{
+			{Field: "age", Operator: OpGte, Value: 18},
+			{Field: "age", Operator: OpLte, Value: 65},
+			{Field: "status", Operator: OpEq, Value: "active"},
+		}, filter.Conditions)
+		assert.Len(t, filter.Get("age"), 2)
+	})
+
+	t.Run("in operator", func(t *testing.T) {
+		require.NoError(t, schema.ApplyURLValues(url.Values{"filter[status][in]": {"active, pending"}}))
+		assert.Equal(t, []FilterCondition{{Field: "status", Operator: OpIn, Value: []interface{}{"active", "pending"}}}, filter.Conditions)
+	})
+
+	t.Run("repeated keys", func(t *testing.T) {
+		require.NoError(t, schema.ApplyURLValues(url.Values{"filter[status][in]": {"active, pending", "archived"}}))
+		assert.Equal(t, []FilterCondition{{Field: "status", Operator: OpIn, Value: []interface{}{"active", "pending", "archived"}}}, filter.Conditions)
+
+		err := schema.ApplyURLValues(url.Values{"filter[status]": {"active", "archived"}})
+		assert.EqualError(t, err, `filter: status: operator "eq" takes a single value`)
+		assert.Empty(t, filter.Conditions)
+	})
+
+	t.Run("json object", func(t *testing.T) {
+		require.NoError(t, schema.ApplyJSON([]byte(`{"filter": {"status": "active", "age": {"gte": 18}}}`)))
+		assert.Equal(t, []FilterCondition{
+			{Field: "age", Operator: OpGte, Value: 18},
+			{Field: "status", Operator: OpEq, Value: "active"},
+		}, filter.Conditions)
+	})
+
+	t.Run("whitelists", func(t *testing.T) {
+		values := url.Values{"filter[password]": {"x"}, "filter[age][gt]": {"18"}}
+		assert.EqualError(t, schema.ApplyURLValues(values), `filter: age: operator "gt" is not allowed; password: field is not filterable`)
+		assert.Empty(t, filter.Conditions)
+	})
+
+	t.Run("no filter", func(t *testing.T) {
+		require.NoError(t, schema.ApplyURLValues(url.Values{}))
+		assert.Empty(t, filter.Conditions)
+		assert.False(t, schema.IsFieldPresent("filter"))
+	})
+}
@@ -0,0 +1,314 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Position is a GeoJSON position: longitude, latitude and an optional altitude
+type Position []float64
+
+// Geometry is a GeoJSON geometry
+type Geometry struct {
+	// Type is the GeoJSON type, e.g. "Point" or "Polygon"
+	Type string
+	// Coordinates depends on the type:
+	// Position for Point, []Position for MultiPoint and LineString,
+	// [][]Position for MultiLineString and Polygon, [][][]Position for MultiPolygon
+	Coordinates interface{}
+}
+
+// geometryDepths holds the nesting depth of the coordinates of each supported type
+var geometryDepths = map[string]int{
+	"Point":           0,
+	"MultiPoint":      1,
+	"LineString":      1,
+	"MultiLineString": 2,
+	"Polygon":         2,
+	"MultiPolygon":    3,
+}
+
+// GeoJSONField represents a GeoJSON geometry field
+type GeoJSONField struct {
+	name        string
+	description string
+	ptr         *Geometry
+	Validators  []Validator
+	wasAssigned bool // Track if a non-nil value was assigned
+}
+
+// Name returns the field name
+func (f *GeoJSONField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *GeoJSONField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *GeoJSONField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *GeoJSONField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign assigns a value to the field from the input data.
+// The geometry is an object, or a JSON string for form inputs.
+func (f *GeoJSONField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	if value == nil {
+		return nil
+	}
+
+	if str, ok := value.(string); ok {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(str), &decoded); err != nil {
+			return fmt.Errorf("invalid GeoJSON: %v", err)
+		}
+		value = decoded
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected a GeoJSON object, got %T", value)
+	}
+
+	geometry, err := parseGeometry(obj)
+	if err != nil {
+		return err
+	}
+
+	*f.ptr = geometry
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *GeoJSONField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *GeoJSONField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *GeoJSONField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*Geometry)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the value as a Geometry.
+func (f *GeoJSONField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, f.Value(), f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *GeoJSONField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *GeoJSONField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *GeoJSONField) describeField(info *FieldInfo) {
+	info.Type = "geojson"
+}
+
+// parseGeometry parses and checks a GeoJSON geometry object
+func parseGeometry(obj map[string]interface{}) (Geometry, error) {
+	geometryType, _ := obj["type"].(string)
+	depth, ok := geometryDepths[geometryType]
+	if !ok {
+		return Geometry{}, fmt.Errorf("unsupported geometry type %q", geometryType)
+	}
+
+	raw, exists := obj["coordinates"]
+	if !exists {
+		return Geometry{}, fmt.Errorf("coordinates are required")
+	}
+
+	var coordinates interface{}
+	var err error
+	switch depth {
+	case 0:
+		coordinates, err = parsePosition(raw)
+	case 1:
+		var positions []Position
+		positions, err = parsePositions(raw)
+		if err == nil && geometryType == "LineString" && len(positions) < 2 {
+			err = fmt.Errorf("a LineString must have at least 2 positions")
+		}
+		coordinates = positions
+	case 2:
+		var lines [][]Position
+		lines, err = parseLines(raw, geometryType == "Polygon")
+		coordinates = lines
+	case 3:
+		var polygons [][][]Position
+		polygons, err = parsePolygons(raw)
+		coordinates = polygons
+	}
+
+	if err != nil {
+		return Geometry{}, err
+	}
+
+	return Geometry{Type: geometryType, Coordinates: coordinates}, nil
+}
+
+// parsePosition parses a position and checks its longitude and latitude ranges
+func parsePosition(raw interface{}) (Position, error) {
+	items, ok := raw.([]interface{})
+	if !ok || len(items) < 2 || len(items) > 3 {
+		return nil, fmt.Errorf("a position must have 2 or 3 numbers")
+	}
+
+	position := make(Position, len(items))
+	for i, item := range items {
+		if _, isString := item.(string); isString {
+			return nil, fmt.Errorf("a position must have 2 or 3 numbers")
+		}
+
+		n, _, err := toFloat64(item)
+		if err != nil {
+			return nil, fmt.Errorf("a position must have 2 or 3 numbers")
+		}
+		position[i] = n
+	}
+
+	if position[0] < -180 || position[0] > 180 {
+		return nil, fmt.Errorf("longitude %v out of range [-180, 180]", position[0])
+	}
+	if position[1] < -90 || position[1] > 90 {
+		return nil, fmt.Errorf("latitude %v out of range [-90, 90]", position[1])
+	}
+
+	return position, nil
+}
+
+// parsePositions parses an array of positions
+func parsePositions(raw interface{}) ([]Position, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an array of positions")
+	}
+
+	positions := make([]Position, len(items))
+	for i, item := range items {
+		position, err := parsePosition(item)
+		if err != nil {
+			return nil, fmt.Errorf("position %d: %v", i, err)
+		}
+		positions[i] = position
+	}
+
+	return positions, nil
+}
+
+// parseLines parses an array of lines; rings must be closed and have at least 4 positions
+func parseLines(raw interface{}, rings bool) ([][]Position, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an array of lines")
+	}
+
+	lines := make([][]Position, len(items))
+	for i, item := range items {
+		positions, err := parsePositions(item)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i, err)
+		}
+
+		if rings {
+			if len(positions) < 4 {
+				return nil, fmt.Errorf("ring %d: must have at least 4 positions", i)
+			}
+			if !positionsEqual(positions[0], positions[len(positions)-1]) {
+				return nil, fmt.Errorf("ring %d: is not closed", i)
+			}
+		} else if len(positions) < 2 {
+			return nil, fmt.Errorf("line %d: must have at least 2 positions", i)
+		}
+
+		lines[i] = positions
+	}
+
+	if rings && len(lines) == 0 {
+		return nil, fmt.Errorf("a Polygon must have at least 1 ring")
+	}
+
+	return lines, nil
+}
+
+// parsePolygons parses an array of polygons
+func parsePolygons(raw interface{}) ([][][]Position, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an array of polygons")
+	}
+
+	polygons := make([][][]Position, len(items))
+	for i, item := range items {
+		rings, err := parseLines(item, true)
+		if err != nil {
+			return nil, fmt.Errorf("polygon %d: %v", i, err)
+		}
+		polygons[i] = rings
+	}
+
+	return polygons, nil
+}
+
+// positionsEqual reports whether two positions are identical
+func positionsEqual(a, b Position) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GeoJSON creates a field holding a GeoJSON geometry (Point, MultiPoint, LineString, MultiLineString,
+// Polygon or MultiPolygon). The structure is checked during assignment: positions have 2 or 3 numbers
+// with longitude and latitude in range, and polygon rings are closed with at least 4 positions.
+func GeoJSON(name string, ptr *Geometry, opts ...Option) Field {
+	field := &GeoJSONField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
@@ -0,0 +1,51 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoJSONField(t *testing.T) {
+	var area Geometry
+	schema := NewSchema(GeoJSON("area", &area, WithValidators(Required())))
+
+	t.Run("point", func(t *testing.T) {
+		require.NoError(t, schema.ApplyJSON([]byte(`{"area": {"type": "Point", "coordinates": [2.35, 48.85]}}`)))
+		assert.Equal(t, Geometry{Type: "Point", Coordinates: Position{2.35, 48.85}}, area)
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		require.NoError(t, schema.ApplyJSON([]byte(`{"area": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}}`)))
+		assert.Equal(t, "Polygon", area.Type)
+		assert.Equal(t, [][]Position{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, area.Coordinates)
+	})
+
+	t.Run("json string from a form", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"area": `{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}`}))
+		assert.Equal(t, []Position{{0, 0}, {1, 1}}, area.Coordinates)
+	})
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"unsupported type", `{"type": "Circle", "coordinates": [0, 0]}`, `area: unsupported geometry type "Circle"`},
+		{"missing coordinates", `{"type": "Point"}`, "area: coordinates are required"},
+		{"longitude out of range", `{"type": "Point", "coordinates": [200, 0]}`, "area: longitude 200 out of range [-180, 180]"},
+		{"latitude out of range", `{"type": "MultiPoint", "coordinates": [[0, 0], [0, -91]]}`, "area: position 1: latitude -91 out of range [-90, 90]"},
+		{"bad position", `{"type": "Point", "coordinates": ["0", 0]}`, "area: a position must have 2 or 3 numbers"},
+		{"open ring", `{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}`, "area: ring 0: is not closed"},
+		{"short ring", `{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [0, 0]]]]}`, "area: polygon 0: ring 0: must have at least 4 positions"},
+		{"short line", `{"type": "LineString", "coordinates": [[0, 0]]}`, "area: a LineString must have at least 2 positions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := NewSchema(GeoJSON("area", &area))
+			assert.EqualError(t, schema.ApplyJSON([]byte(`{"area": `+tt.input+`}`)), tt.err)
+		})
+	}
+}
@@ -14,8 +14,10 @@ type HTTPMapField[K comparable, V any] struct {
 	callback     func(*Schema, *V)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue map[K]V
 	hasDefault   bool
+	maxElements  int // Maximum number of entries accepted, 0 means unlimited
 }
 
 // Name returns the field name
@@ -46,8 +48,16 @@ func (f *HTTPMapField[K, V]) SetDescription(description string) {
 	f.description = description
 }
 
+// SetMaxElements sets the maximum number of entries accepted by the field
+func (f *HTTPMapField[K, V]) SetMaxElements(n int) {
+	f.maxElements = n
+}
+
 // Assign assigns a value to the field from the input data
 func (f *HTTPMapField[K, V]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	result := make(map[K]V)
 
 	values := convertToURLValues(data)
@@ -60,6 +70,7 @@ func (f *HTTPMapField[K, V]) Assign(data map[string]interface{}, schema *Schema)
 		if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		} else {
 			f.wasAssigned = false
@@ -68,17 +79,24 @@ func (f *HTTPMapField[K, V]) Assign(data map[string]interface{}, schema *Schema)
 		return nil
 	}
 
+	// Reject oversized inputs before building any sub-schema
+	if f.maxElements > 0 {
+		if err := checkMaxElements(len(formData), f.maxElements); err != nil {
+			return err
+		}
+	}
+
 	for key, value := range formData {
-		convertedKey, err := convertValue[K](key)
+		convertedKey, err := convertKey[K](key)
 		if err != nil {
-			return fmt.Errorf("key %s: failed to convert: %v", key, err)
+			return KeyErrors{{Key: key, Error: fmt.Errorf("failed to convert: %v", err)}}
 		}
 
 		var element V
 		subSchema := NewSchema()
 		f.callback(subSchema, &element)
-		if err := subSchema.Apply(convertMapStringStringToMapStringInterface(value)); err != nil {
-			return fmt.Errorf("key %s: %v", key, err)
+		if err := subSchema.Apply(convertMapStringStringToMapStringInterface(value), schema.subSchemaOptions(joinPath(f.name, key))...); err != nil {
+			return KeyErrors{{Key: key, Error: err}}
 		}
 		result[convertedKey] = element
 	}
@@ -88,6 +106,23 @@ func (f *HTTPMapField[K, V]) Assign(data map[string]interface{}, schema *Schema)
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *HTTPMapField[K, V]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *HTTPMapField[K, V]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *HTTPMapField[K, V]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*map[K]V)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *HTTPMapField[K, V]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
@@ -98,6 +133,25 @@ func (f *HTTPMapField[K, V]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *HTTPMapField[K, V]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *HTTPMapField[K, V]) describeField(info *FieldInfo) {
+	info.Type = "map[" + typeName[K]() + "]" + typeName[V]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+	if f.callback != nil {
+		subSchema := NewSchema()
+		f.callback(subSchema, new(V))
+		info.Fields = subSchema.Describe()
+	}
+}
+
 // SetCallback sets the callback function for configuring sub-schemas
 func (f *HTTPMapField[K, V]) SetCallback(callback func(*Schema, *V)) {
 	f.callback = callback
@@ -0,0 +1,321 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MoneyValue represents an amount of money in the minor unit of its currency (e.g. cents)
+type MoneyValue struct {
+	// Amount is expressed in the minor unit of the currency (1234 is 12.34 EUR, 1234 JPY)
+	Amount int64
+	// Currency is the ISO 4217 currency code (e.g. "EUR")
+	Currency string
+}
+
+// String returns the amount in major units followed by the currency (e.g. "12.34 EUR")
+func (m MoneyValue) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// Decimal returns the amount in major units (e.g. "12.34")
+func (m MoneyValue) Decimal() string {
+	digits, _ := CurrencyDigits(m.Currency)
+	if digits == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	// The magnitude is unsigned, -math.MinInt64 overflowing an int64
+	sign := ""
+	amount := uint64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	str := strconv.FormatUint(amount, 10)
+	if len(str) <= digits {
+		str = strings.Repeat("0", digits-len(str)+1) + str
+	}
+
+	return sign + str[:len(str)-digits] + "." + str[len(str)-digits:]
+}
+
+// MoneySetter is implemented by custom types that can be bound to a Money field
+type MoneySetter interface {
+	SetMoney(value MoneyValue) error
+}
+
+// MoneyField represents a money amount field
+type MoneyField[T any] struct {
+	name            string
+	description     string
+	ptr             *T
+	value           MoneyValue
+	Validators      []Validator
+	wasAssigned     bool // Track if a non-nil value was assigned
+	defaultCurrency string
+}
+
+// Name returns the field name
+func (f *MoneyField[T]) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field as a MoneyValue
+func (f *MoneyField[T]) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return f.value
+}
+
+// Description returns the field description
+func (f *MoneyField[T]) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *MoneyField[T]) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultCurrency sets the currency used when the input doesn't provide one
+func (f *MoneyField[T]) SetDefaultCurrency(currency string) {
+	f.defaultCurrency = currency
+}
+
+// Assign assigns a value to the field from the input data
+func (f *MoneyField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.value = MoneyValue{}
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	if value == nil {
+		return nil
+	}
+
+	money, err := parseMoney(value, f.defaultCurrency)
+	if err != nil {
+		return err
+	}
+
+	switch ptr := any(f.ptr).(type) {
+	case *MoneyValue:
+		*ptr = money
+	case MoneySetter:
+		if err := ptr.SetMoney(money); err != nil {
+			return err
+		}
+	}
+
+	f.value = money
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *MoneyField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *MoneyField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *MoneyField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*T)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators.
+// Validators receive the value as a MoneyValue.
+func (f *MoneyField[T]) Validate(schema *Schema) error {
+	var value interface{}
+	if f.wasAssigned {
+		value = f.value
+	}
+
+	return validateFieldValidators(f.Validators, value, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *MoneyField[T]) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *MoneyField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *MoneyField[T]) describeField(info *FieldInfo) {
+	info.Type = typeName[T]()
+}
+
+// parseMoney parses a money amount from an object like {"amount": "12.34", "currency": "EUR"}
+// or from an integer amount in minor units using the default currency.
+// String amounts are in major units, integer amounts in minor units.
+func parseMoney(value interface{}, defaultCurrency string) (MoneyValue, error) {
+	rawAmount := value
+	currency := defaultCurrency
+
+	if obj, ok := value.(map[string]interface{}); ok {
+		rawAmount = obj["amount"]
+		if rawCurrency, ok := obj["currency"]; ok && rawCurrency != nil {
+			str, ok := rawCurrency.(string)
+			if !ok {
+				return MoneyValue{}, fmt.Errorf("currency must be a string, got %T", rawCurrency)
+			}
+			currency = strings.ToUpper(strings.TrimSpace(str))
+		}
+	}
+
+	if currency == "" {
+		return MoneyValue{}, fmt.Errorf("currency is required")
+	}
+
+	digits, ok := CurrencyDigits(currency)
+	if !ok {
+		return MoneyValue{}, newValidationError(CodeCurrency, "currency", currency)
+	}
+
+	if rawAmount == nil {
+		return MoneyValue{}, fmt.Errorf("amount is required")
+	}
+
+	var amount int64
+	switch v := rawAmount.(type) {
+	case string:
+		parsed, err := parseDecimalAmount(v, digits)
+		if err != nil {
+			return MoneyValue{}, err
+		}
+		amount = parsed
+	case json.Number:
+		parsed, err := v.Int64()
+		if err != nil {
+			return MoneyValue{}, fmt.Errorf("integer amounts must be in minor units, got %s", v)
+		}
+		amount = parsed
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) >= 1<<63 {
+			return MoneyValue{}, fmt.Errorf("integer amounts must be in minor units, got %v", v)
+		}
+		amount = int64(v)
+	case int:
+		amount = int64(v)
+	case int32:
+		amount = int64(v)
+	case int64:
+		amount = v
+	default:
+		return MoneyValue{}, fmt.Errorf("cannot convert %T to a money amount", rawAmount)
+	}
+
+	return MoneyValue{Amount: amount, Currency: currency}, nil
+}
+
+// parseDecimalAmount parses a decimal amount like "12.34" into minor units
+func parseDecimalAmount(str string, digits int) (int64, error) {
+	str = strings.TrimSpace(str)
+
+	unsigned := str
+	negative := strings.HasPrefix(unsigned, "-")
+	if negative {
+		unsigned = unsigned[1:]
+	} else {
+		unsigned = strings.TrimPrefix(unsigned, "+")
+	}
+
+	intPart, fracPart, _ := strings.Cut(unsigned, ".")
+	if intPart == "" || !isDigits(intPart) || (fracPart != "" && !isDigits(fracPart)) {
+		return 0, fmt.Errorf("invalid amount %q", str)
+	}
+
+	if len(fracPart) > digits {
+		return 0, newValidationError(CodeMoneyScale, "digits", strconv.Itoa(digits))
+	}
+	fracPart += strings.Repeat("0", digits-len(fracPart))
+
+	// The magnitude is parsed unsigned, for the minimum int64 amount formatted by Decimal to be accepted
+	magnitude, err := strconv.ParseUint(intPart+fracPart, 10, 64)
+	if err != nil || magnitude > math.MaxInt64 && !(negative && magnitude == 1<<63) {
+		return 0, fmt.Errorf("invalid amount %q", str)
+	}
+
+	if negative {
+		return -int64(magnitude), nil
+	}
+
+	return int64(magnitude), nil
+}
+
+// isDigits reports whether a string only contains ASCII digits
+func isDigits(str string) bool {
+	for _, r := range str {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MoneyCurrencyOption holds the default currency of a money field
+type MoneyCurrencyOption struct {
+	currency string
+}
+
+// Apply applies the default currency to the field
+func (o MoneyCurrencyOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetDefaultCurrency(string) }); ok {
+		f.SetDefaultCurrency(o.currency)
+	} else {
+		panic(fmt.Sprintf("WithCurrency doesn't support %T", field))
+	}
+}
+
+// WithCurrency sets the currency of a money field when the input doesn't provide one,
+// allowing plain integer amounts in minor units
+func WithCurrency(currency string) Option {
+	return MoneyCurrencyOption{currency: currency}
+}
+
+// Money creates a money field.
+// The input is either an object like {"amount": "12.34", "currency": "EUR"} or, with WithCurrency,
+// an integer amount in minor units. String amounts are in major units and may not have more decimal
+// places than the currency allows; integer amounts are in minor units.
+//
+// ptr must be a *MoneyValue or implement MoneySetter.
+func Money[T any](name string, ptr *T, opts ...Option) Field {
+	switch any(ptr).(type) {
+	case *MoneyValue, MoneySetter:
+	default:
+		panic(fmt.Sprintf("Money doesn't support %T, use *poxxy.MoneyValue or a MoneySetter", ptr))
+	}
+
+	field := &MoneyField[T]{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
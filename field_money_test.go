@@ -0,0 +1,108 @@
+package poxxy
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPrice struct {
+	cents    int64
+	currency string
+}
+
+func (p *testPrice) SetMoney(value MoneyValue) error {
+	p.cents = value.Amount
+	p.currency = value.Currency
+	return nil
+}
+
+func TestMoneyField(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		opts     []Option
+		expected MoneyValue
+		wantErr  string
+	}{
+		{"decimal string", map[string]interface{}{"amount": "12.34", "currency": "EUR"}, nil, MoneyValue{Amount: 1234, Currency: "EUR"}, ""},
+		{"short decimal", map[string]interface{}{"amount": "12.5", "currency": "eur"}, nil, MoneyValue{Amount: 1250, Currency: "EUR"}, ""},
+		{"negative", map[string]interface{}{"amount": "-0.05", "currency": "USD"}, nil, MoneyValue{Amount: -5, Currency: "USD"}, ""},
+		{"minor units", map[string]interface{}{"amount": float64(1234), "currency": "EUR"}, nil, MoneyValue{Amount: 1234, Currency: "EUR"}, ""},
+		{"zero decimal currency", map[string]interface{}{"amount": "1500", "currency": "JPY"}, nil, MoneyValue{Amount: 1500, Currency: "JPY"}, ""},
+		{"three decimal currency", map[string]interface{}{"amount": "1.125", "currency": "KWD"}, nil, MoneyValue{Amount: 1125, Currency: "KWD"}, ""},
+		{"integer with default currency", 999, []Option{WithCurrency("EUR")}, MoneyValue{Amount: 999, Currency: "EUR"}, ""},
+		{"too many decimals", map[string]interface{}{"amount": "12.345", "currency": "EUR"}, nil, MoneyValue{}, "amount must have at most 2 decimal places"},
+		{"decimals on zero decimal currency", map[string]interface{}{"amount": "10.5", "currency": "JPY"}, nil, MoneyValue{}, "amount must have at most 0 decimal places"},
+		{"unknown currency", map[string]interface{}{"amount": "1", "currency": "XYZ"}, nil, MoneyValue{}, "invalid currency code XYZ"},
+		{"missing currency", 999, nil, MoneyValue{}, "currency is required"},
+		{"fractional number", map[string]interface{}{"amount": 12.34, "currency": "EUR"}, nil, MoneyValue{}, "integer amounts must be in minor units, got 12.34"},
+		{"invalid amount", map[string]interface{}{"amount": "12,34", "currency": "EUR"}, nil, MoneyValue{}, `invalid amount "12,34"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var price MoneyValue
+			schema := NewSchema(Money("price", &price, tt.opts...))
+
+			err := schema.Apply(map[string]interface{}{"price": tt.input})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, "price: "+tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, price)
+		})
+	}
+
+	t.Run("binds to a MoneySetter", func(t *testing.T) {
+		var price testPrice
+		schema := NewSchema(Money("price", &price))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"price": map[string]interface{}{"amount": "9.99", "currency": "GBP"}}))
+		assert.Equal(t, testPrice{cents: 999, currency: "GBP"}, price)
+	})
+
+	t.Run("validators receive a MoneyValue", func(t *testing.T) {
+		var price MoneyValue
+		schema := NewSchema(Money("price", &price, WithValidators(
+			Required(),
+			ValidatorFunc(func(m MoneyValue, fieldName string) error {
+				if m.Amount <= 0 {
+					return fmt.Errorf("must be positive")
+				}
+				return nil
+			}),
+		)))
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{}), "price: field is required")
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"price": map[string]interface{}{"amount": "0", "currency": "EUR"}}), "price: must be positive")
+	})
+
+	t.Run("unsupported pointer", func(t *testing.T) {
+		var price float64
+		assert.Panics(t, func() { Money("price", &price) })
+	})
+}
+
+func TestMoneyValue_String(t *testing.T) {
+	assert.Equal(t, "12.34 EUR", MoneyValue{Amount: 1234, Currency: "EUR"}.String())
+	assert.Equal(t, "-0.05 USD", MoneyValue{Amount: -5, Currency: "USD"}.String())
+	assert.Equal(t, "1500 JPY", MoneyValue{Amount: 1500, Currency: "JPY"}.String())
+	assert.Equal(t, "1.125 KWD", MoneyValue{Amount: 1125, Currency: "KWD"}.String())
+	assert.Equal(t, "-92233720368547758.08 EUR", MoneyValue{Amount: math.MinInt64, Currency: "EUR"}.String())
+
+	for _, amount := range []int64{math.MinInt64, math.MaxInt64, -5} {
+		parsed, err := parseMoney(MoneyValue{Amount: amount, Currency: "EUR"}.Decimal(), "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, amount, parsed.Amount)
+	}
+	_, err := parseMoney("92233720368547758.08", "EUR")
+	assert.EqualError(t, err, `invalid amount "92233720368547758.08"`)
+	_, err = parseMoney(float64(1<<63), "EUR")
+	assert.Error(t, err)
+}
@@ -12,8 +12,10 @@ type NestedMapField[K comparable, V any] struct {
 	callback     func(*Schema, K, *V)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue map[K]V
 	hasDefault   bool
+	maxElements  int // Maximum number of entries accepted, 0 means unlimited
 }
 
 // Name returns the field name
@@ -50,14 +52,23 @@ func (f *NestedMapField[K, V]) SetDefaultValue(defaultValue map[K]V) {
 	f.hasDefault = true
 }
 
+// SetMaxElements sets the maximum number of entries accepted by the field
+func (f *NestedMapField[K, V]) SetMaxElements(n int) {
+	f.maxElements = n
+}
+
 // Assign assigns a value to the field from the input data
 func (f *NestedMapField[K, V]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
 		if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 		return nil
@@ -80,19 +91,26 @@ func (f *NestedMapField[K, V]) Assign(data map[string]interface{}, schema *Schem
 		return fmt.Errorf("expected map for nested map field")
 	}
 
+	// Reject oversized inputs before converting any entry
+	if f.maxElements > 0 {
+		if err := checkMaxElements(len(mapData), f.maxElements); err != nil {
+			return err
+		}
+	}
+
 	result := make(map[K]V)
 
 	for key, val := range mapData {
 		// Convert key to type K
-		convertedKey, err := convertValue[K](key)
+		convertedKey, err := convertKey[K](key)
 		if err != nil {
-			return err
+			return KeyErrors{{Key: key, Error: err}}
 		}
 
 		// Convert value to type V
 		convertedVal, err := convertValue[V](val)
 		if err != nil {
-			return err
+			return KeyErrors{{Key: key, Error: err}}
 		}
 
 		result[convertedKey] = convertedVal
@@ -111,6 +129,23 @@ func (f *NestedMapField[K, V]) Assign(data map[string]interface{}, schema *Schem
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *NestedMapField[K, V]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *NestedMapField[K, V]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *NestedMapField[K, V]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*map[K]V)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *NestedMapField[K, V]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
@@ -121,6 +156,20 @@ func (f *NestedMapField[K, V]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *NestedMapField[K, V]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *NestedMapField[K, V]) describeField(info *FieldInfo) {
+	info.Type = "map[" + typeName[K]() + "]" + typeName[V]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
 // SetCallback implements SubSchemaMapInterface
 func (f *NestedMapField[K, V]) SetCallback(callback func(*Schema, K, V)) {
 	// Convert the callback signature to match our internal callback
@@ -0,0 +1,276 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NumberField represents a numeric field accepting integer and float inputs into a float64
+type NumberField struct {
+	name          string
+	description   string
+	ptr           *float64
+	Validators    []Validator
+	wasAssigned   bool // Track if a non-nil value was assigned
+	defaulted     bool // Track if the default value was applied
+	defaultValue  float64
+	hasDefault    bool
+	rejectInexact bool
+	strictNumbers bool // Reject numeric strings not in plain decimal notation
+}
+
+// Name returns the field name
+func (f *NumberField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *NumberField) Value() interface{} {
+	if f.ptr == nil || !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *NumberField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *NumberField) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultValue sets the default value for the field
+func (f *NumberField) SetDefaultValue(defaultValue float64) {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+}
+
+// SetRejectPrecisionLoss makes the field reject inputs that can't be represented exactly as a float64
+func (f *NumberField) SetRejectPrecisionLoss(reject bool) {
+	f.rejectInexact = reject
+}
+
+// SetStrictNumericStrings makes the field reject numeric strings not in plain decimal notation
+func (f *NumberField) SetStrictNumericStrings(strict bool) {
+	f.strictNumbers = strict
+}
+
+// Assign assigns a value to the field from the input data
+func (f *NumberField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		// Apply default value if available
+		if f.hasDefault {
+			*f.ptr = f.defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		}
+		return nil // Will be caught by Required validator if needed
+	}
+	schema.SetFieldPresent(f.name)
+
+	if f.strictNumbers {
+		if err := checkPlainDecimal[float64](value); err != nil {
+			return err
+		}
+	}
+
+	n, exact, err := toFloat64(value)
+	if err != nil {
+		return err
+	}
+
+	if !exact && f.rejectInexact {
+		return fmt.Errorf("%v cannot be represented exactly as a float64", value)
+	}
+
+	*f.ptr = n
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *NumberField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *NumberField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *NumberField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*float64)
+	return &clone
+}
+
+// Validate validates the field value using all registered validators
+func (f *NumberField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *NumberField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *NumberField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *NumberField) describeField(info *FieldInfo) {
+	info.Type = "float64"
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
+// toFloat64 converts an integer, float, json.Number or numeric string to a float64,
+// reporting whether the conversion is exact
+func toFloat64(value interface{}) (float64, bool, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return parseFloat64(v.String())
+	case string:
+		return parseFloat64(strings.TrimSpace(v))
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, accuracy := new(big.Float).SetInt64(rv.Int()).Float64()
+		return n, accuracy == big.Exact, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, accuracy := new(big.Float).SetUint64(rv.Uint()).Float64()
+		return n, accuracy == big.Exact, nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true, nil
+	default:
+		return 0, false, fmt.Errorf("expected number, got %T", value)
+	}
+}
+
+// parseFloat64 parses a decimal number, reporting whether the float64 value is the same
+// number (e.g. "0.1" is, "9007199254740993" is not as it rounds to 9007199254740992)
+func parseFloat64(str string) (float64, bool, error) {
+	n, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid number %q", str)
+	}
+
+	input, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return 0, false, fmt.Errorf("invalid number %q", str)
+	}
+
+	// Compare with the shortest decimal representation of the float64
+	output, _ := new(big.Rat).SetString(strconv.FormatFloat(n, 'g', -1, 64))
+	return n, input.Cmp(output) == 0, nil
+}
+
+// PrecisionOption holds the precision loss behavior of a number field
+type PrecisionOption struct {
+	reject bool
+}
+
+// Apply applies the option to the field
+func (o PrecisionOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetRejectPrecisionLoss(bool) }); ok {
+		f.SetRejectPrecisionLoss(o.reject)
+	} else {
+		panic(fmt.Sprintf("RejectPrecisionLoss doesn't support %T", field))
+	}
+}
+
+// RejectPrecisionLoss makes a number field reject inputs that can't be represented exactly as a float64,
+// such as integers above 2^53 or decimals with too many significant digits given as strings or json.Number.
+// Note that numbers decoded by encoding/json without UseNumber are already float64.
+func RejectPrecisionLoss() Option {
+	return PrecisionOption{reject: true}
+}
+
+// plainDecimalRegex matches numbers in plain decimal notation like "-12" or "3.50"
+var plainDecimalRegex = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// checkPlainDecimal rejects the strings and json.Number not in plain decimal notation
+// (e.g. "1e5", "0x10", "Inf" or "1_000") when T is a numeric type
+func checkPlainDecimal[T any](value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	default:
+		return nil
+	}
+
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return nil
+	}
+
+	if !plainDecimalRegex.MatchString(strings.TrimSpace(str)) {
+		return fmt.Errorf("invalid number %q, expected a plain decimal number", str)
+	}
+
+	return nil
+}
+
+// StrictNumericStringsOption holds the numeric strings behavior of a field
+type StrictNumericStringsOption struct {
+	strict bool
+}
+
+// Apply applies the option to the field
+func (o StrictNumericStringsOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetStrictNumericStrings(bool) }); ok {
+		f.SetStrictNumericStrings(o.strict)
+	} else {
+		panic(fmt.Sprintf("StrictNumericStrings doesn't support %T", field))
+	}
+}
+
+// StrictNumericStrings makes a numeric field (Value, Pointer, Slice or Number) accept numeric strings
+// in plain decimal notation only ("-12", "3.50"), rejecting the exponents, hexadecimal, underscores,
+// "Inf" and "NaN" that strconv-based parsing accepts ("1e5", "0x10", "1_000"), for finance-grade endpoints.
+// It also applies to json.Number inputs; numbers already decoded as float64 are not affected.
+func StrictNumericStrings() Option {
+	return StrictNumericStringsOption{strict: true}
+}
+
+// Number creates a field accepting integer and float inputs (numbers, json.Number and numeric strings) into a float64,
+// for metrics-style endpoints where clients send 1 or 1.5 interchangeably
+func Number(name string, ptr *float64, opts ...Option) Field {
+	field := &NumberField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
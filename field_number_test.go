@@ -0,0 +1,91 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberField(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		opts     []Option
+		expected float64
+		wantErr  string
+	}{
+		{"int", 42, nil, 42, ""},
+		{"float", 1.5, nil, 1.5, ""},
+		{"int64", int64(-7), nil, -7, ""},
+		{"json number", json.Number("0.1"), nil, 0.1, ""},
+		{"numeric string", " 3e2 ", nil, 300, ""},
+		{"decimal is exact", "0.1", []Option{RejectPrecisionLoss()}, 0.1, ""},
+		{"large int loses precision", int64(9007199254740993), nil, 9007199254740992, ""},
+		{"large int rejected", int64(9007199254740993), []Option{RejectPrecisionLoss()}, 0, "number: 9007199254740993 cannot be represented exactly as a float64"},
+		{"long decimal rejected", json.Number("0.12345678901234567890123"), []Option{RejectPrecisionLoss()}, 0, "number: 0.12345678901234567890123 cannot be represented exactly as a float64"},
+		{"bool", true, nil, 0, "number: expected number, got bool"},
+		{"invalid string", "abc", nil, 0, `number: invalid number "abc"`},
+		{"exponent", "1e5", nil, 100000, ""},
+		{"strict decimal", "-12.50", []Option{StrictNumericStrings()}, -12.5, ""},
+		{"strict exponent", "1e5", []Option{StrictNumericStrings()}, 0, `number: invalid number "1e5", expected a plain decimal number`},
+		{"strict infinity", "Inf", []Option{StrictNumericStrings()}, 0, `number: invalid number "Inf", expected a plain decimal number`},
+		{"strict json.Number exponent", json.Number("2E3"), []Option{StrictNumericStrings()}, 0, `number: invalid number "2E3", expected a plain decimal number`},
+		{"strict float", 1e5, []Option{StrictNumericStrings()}, 100000, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n float64
+			schema := NewSchema(Number("number", &n, tt.opts...))
+
+			err := schema.Apply(map[string]interface{}{"number": tt.input})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, n)
+		})
+	}
+
+	t.Run("default and validators", func(t *testing.T) {
+		var n float64
+		schema := NewSchema(Number("ratio", &n, WithDefault(0.5), WithValidators(Ratio())))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{}))
+		assert.Equal(t, 0.5, n)
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"ratio": 2}), "ratio: must be a ratio between 0 and 1")
+	})
+}
+
+func TestStrictNumericStrings(t *testing.T) {
+	var amount int64
+	var price *float64
+	var ids []int
+	var name string
+
+	schema := NewSchema(
+		Value("amount", &amount, StrictNumericStrings()),
+		Pointer("price", &price, StrictNumericStrings()),
+		Slice("ids", &ids, StrictNumericStrings()),
+		Value("name", &name, StrictNumericStrings()),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"amount": "1000", "price": "9.99", "ids": []interface{}{"1", 2}, "name": "0x10"}))
+	assert.Equal(t, int64(1000), amount)
+	assert.Equal(t, 9.99, *price)
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, "0x10", name)
+
+	err := schema.Apply(map[string]interface{}{"amount": "0x10", "price": "1e5", "ids": []interface{}{"1", "1_000"}})
+	assert.EqualError(t, err, `amount: invalid number "0x10", expected a plain decimal number; `+
+		`price: invalid number "1e5", expected a plain decimal number; `+
+		`ids: element 1: invalid number "1_000", expected a plain decimal number`)
+
+	assert.PanicsWithValue(t, "StrictNumericStrings doesn't support *poxxy.StructField[string]", func() {
+		Struct("s", &name, StrictNumericStrings())
+	})
+}
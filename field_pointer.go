@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"reflect"
+	"time"
 )
 
 // PointerField represents a pointer field
@@ -14,9 +15,16 @@ type PointerField[T any] struct {
 	Validators   []Validator
 	callback     func(*Schema, *T)
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue T
 	hasDefault   bool
+	defaultFunc  func(*Schema) (T, error)
+	dependencies []string
 	transformers []Transformer[T]
+	// Reject numeric strings not in plain decimal notation
+	strictNumbers bool
+	// Time layouts and duration unit of the input (see WithTimeLayouts and WithDurationUnit)
+	conversion conversionOptions
 }
 
 // Name returns the field name
@@ -45,6 +53,36 @@ func (f *PointerField[T]) SetDefaultValue(defaultValue T) {
 	f.hasDefault = true
 }
 
+// SetStrictNumericStrings makes the field reject numeric strings not in plain decimal notation
+func (f *PointerField[T]) SetStrictNumericStrings(strict bool) {
+	f.strictNumbers = strict
+}
+
+// SetTimeLayouts sets the layouts of the time strings accepted by the field
+func (f *PointerField[T]) SetTimeLayouts(layouts []string) {
+	f.conversion.timeLayouts = layouts
+}
+
+// SetDurationUnit sets the unit of the integer durations accepted by the field
+func (f *PointerField[T]) SetDurationUnit(unit time.Duration) {
+	f.conversion.durationUnit = unit
+}
+
+// SetDefaultFunc sets a function computing the default value of the field from the schema
+func (f *PointerField[T]) SetDefaultFunc(defaultFunc func(*Schema) (T, error)) {
+	f.defaultFunc = defaultFunc
+}
+
+// AppendDependencies implements DependenciesAppender interface
+func (f *PointerField[T]) AppendDependencies(fieldNames []string) {
+	f.dependencies = append(f.dependencies, fieldNames...)
+}
+
+// GetDependencies implements DependenciesGetter interface
+func (f *PointerField[T]) GetDependencies() []string {
+	return f.dependencies
+}
+
 // Value returns the current value of the field
 func (f *PointerField[T]) Value() interface{} {
 	if f.ptr == nil {
@@ -72,14 +110,35 @@ func (f *PointerField[T]) Value() interface{} {
 
 // Assign assigns a value to the field from the input data
 func (f *PointerField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
+	if exists && value == nil && schema.partial {
+		// An explicit null clears the field of a partial update
+		schema.SetFieldPresent(f.name)
+		*f.ptr = nil
+		f.wasAssigned = true
+		return nil
+	}
+
 	if !exists || isEmpty(value) {
 		// Apply default value if available
-		if f.hasDefault {
+		if f.defaultFunc != nil {
+			defaultValue, err := f.defaultFunc(schema)
+			if err != nil {
+				return err
+			}
+			*f.ptr = &defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		} else if f.hasDefault {
 			instance := new(T)
 			*instance = f.defaultValue
 			*f.ptr = instance
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 
@@ -112,20 +171,23 @@ func (f *PointerField[T]) Assign(data map[string]interface{}, schema *Schema) er
 		subSchema := NewSchema()
 		f.callback(subSchema, instance)
 		f.wasAssigned = true
-		return subSchema.Apply(structData)
+		return subSchema.Apply(structData, schema.subSchemaOptions(f.name)...)
 	} else {
-		converted, err := convertValue[T](value)
+		if f.strictNumbers {
+			if err := checkPlainDecimal[T](value); err != nil {
+				return err
+			}
+		}
+
+		converted, err := convertFieldValue[T](value, f.conversion)
 		if err != nil {
 			return err
 		}
 
 		// Apply transformers
-		transformed := converted
-		for _, transformer := range f.transformers {
-			transformed, err = transformer.Transform(transformed)
-			if err != nil {
-				return err
-			}
+		transformed, err := applyTransformers(f.transformers, converted, f.name, schema)
+		if err != nil {
+			return err
 		}
 
 		**f.ptr = transformed
@@ -135,6 +197,23 @@ func (f *PointerField[T]) Assign(data map[string]interface{}, schema *Schema) er
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *PointerField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *PointerField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *PointerField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(**T)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *PointerField[T]) Validate(schema *Schema) error {
 	if f.ptr == nil || *f.ptr == nil {
@@ -149,6 +228,25 @@ func (f *PointerField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *PointerField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *PointerField[T]) describeField(info *FieldInfo) {
+	info.Type = "*" + typeName[T]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+	if f.callback != nil {
+		subSchema := NewSchema()
+		f.callback(subSchema, new(T))
+		info.Fields = subSchema.Describe()
+	}
+}
+
 // SetCallback sets the callback function for configuring sub-schemas
 func (f *PointerField[T]) SetCallback(callback func(*Schema, *T)) {
 	f.callback = callback
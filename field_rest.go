@@ -0,0 +1,130 @@
+package poxxy
+
+// RestField represents a field collecting the input keys not declared in the schema
+type RestField struct {
+	name        string
+	description string
+	ptr         *map[string]interface{}
+	Validators  []Validator
+	wasAssigned bool // Track if undeclared keys were collected
+}
+
+// Name returns the field name
+func (f *RestField) Name() string {
+	return f.name
+}
+
+// Value returns the collected keys, or nil if the input only had declared keys
+func (f *RestField) Value() interface{} {
+	if f.ptr == nil || !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *RestField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *RestField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign collects the input keys that no other field of the schema reads
+func (f *RestField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	*f.ptr = nil
+
+	var rest map[string]interface{}
+	for key, value := range data {
+		if schema.declaresKey(key) {
+			continue
+		}
+
+		if rest == nil {
+			rest = make(map[string]interface{})
+		}
+		rest[key] = value
+	}
+
+	if rest == nil {
+		return nil
+	}
+
+	*f.ptr = rest
+	f.wasAssigned = true
+	return nil
+}
+
+// assignState implements assignStateReporter interface
+func (f *RestField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *RestField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *RestField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*map[string]interface{})
+	return &clone
+}
+
+// Validate validates the collected keys using all registered validators
+func (f *RestField) Validate(schema *Schema) error {
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *RestField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *RestField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *RestField) describeField(info *FieldInfo) {
+	info.Type = "map[string]any"
+}
+
+// isRestField reports whether a field is a Rest field
+func isRestField(field Field) bool {
+	_, ok := field.(*RestField)
+	return ok
+}
+
+// Rest creates a field collecting the input keys that no other field of the schema reads, with their raw
+// values, so middleware can log or forward them. The name only identifies the field in errors and
+// descriptions: an input key with the same name is collected like any other undeclared key.
+// The map is nil when the input only has declared keys. A schema with a Rest field has no unknown keys
+// for WithDisallowUnknownFields.
+//
+//	var extras map[string]interface{}
+//	poxxy.NewSchema(
+//		poxxy.Value("email", &email),
+//		poxxy.Rest("extras", &extras),
+//	)
+func Rest(name string, ptr *map[string]interface{}, opts ...Option) Field {
+	field := &RestField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
+
+// ignoresInputKey implements inputlessField interface
+func (f *RestField) ignoresInputKey() {}
@@ -0,0 +1,50 @@
+package poxxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRest(t *testing.T) {
+	var email string
+	var filter Filter
+	var extras map[string]interface{}
+
+	schema := NewSchema(
+		Value("email", &email),
+		Filters("filter", &filter, Filterable[string]("status")),
+		Rest("extras", &extras, WithValidators(ValidatorFunc(func(extras map[string]interface{}, fieldName string) error {
+			if len(extras) > 2 {
+				return errors.New("too many extra fields")
+			}
+			return nil
+		}))),
+		Renamed("mail", "email"),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{
+		"mail":           "john@example.com",
+		"filter[status]": "active",
+		"utm_source":     "newsletter",
+		"extras":         map[string]interface{}{"a": 1.0},
+	}))
+	assert.Equal(t, "john@example.com", email)
+	assert.Equal(t, map[string]interface{}{
+		"utm_source": "newsletter",
+		"extras":     map[string]interface{}{"a": 1.0},
+	}, extras)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"email": "jane@example.com"}))
+	assert.Nil(t, extras)
+
+	err := schema.Apply(map[string]interface{}{"a": 1, "b": 2, "c": 3})
+	assert.EqualError(t, err, "extras: too many extra fields")
+
+	t.Run("disallow unknown fields", func(t *testing.T) {
+		assert.NoError(t, schema.Apply(map[string]interface{}{"emial": "john@example.com"}, WithDisallowUnknownFields()))
+		assert.Equal(t, map[string]interface{}{"emial": "john@example.com"}, extras)
+	})
+}
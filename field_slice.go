@@ -13,9 +13,14 @@ type SliceField[T any] struct {
 	callback     func(*Schema, *T)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue []T
 	hasDefault   bool
+	strict       bool // Reject elements that are not of the element type instead of coercing them
+	maxElements  int  // Maximum number of elements accepted, 0 means unlimited
 	transformers []Transformer[[]T]
+	// Reject numeric strings not in plain decimal notation
+	strictNumbers bool
 }
 
 // Name returns the field name
@@ -49,6 +54,21 @@ func (f *SliceField[T]) AddTransformer(transformer Transformer[[]T]) {
 	f.transformers = append(f.transformers, transformer)
 }
 
+// SetStrict sets whether elements must match the element type exactly instead of being coerced
+func (f *SliceField[T]) SetStrict(strict bool) {
+	f.strict = strict
+}
+
+// SetStrictNumericStrings makes the field reject numeric strings not in plain decimal notation
+func (f *SliceField[T]) SetStrictNumericStrings(strict bool) {
+	f.strictNumbers = strict
+}
+
+// SetMaxElements sets the maximum number of elements accepted by the field
+func (f *SliceField[T]) SetMaxElements(n int) {
+	f.maxElements = n
+}
+
 // SetDefaultValue sets the default value for the field
 func (f *SliceField[T]) SetDefaultValue(defaultValue []T) {
 	f.defaultValue = defaultValue
@@ -57,12 +77,16 @@ func (f *SliceField[T]) SetDefaultValue(defaultValue []T) {
 
 // Assign assigns a value to the field from the input data
 func (f *SliceField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
 		if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 
@@ -81,6 +105,15 @@ func (f *SliceField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 		return nil
 	}
 
+	// Reject oversized inputs before converting any element
+	if f.maxElements > 0 {
+		if rValue := reflect.ValueOf(value); rValue.Kind() == reflect.Slice || rValue.Kind() == reflect.Array {
+			if err := checkMaxElements(rValue.Len(), f.maxElements); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Accept []interface{}, []map[string]interface{}, or any slice/array via reflection
 	var slice []interface{}
 
@@ -105,6 +138,9 @@ func (f *SliceField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 
 	result := make([]T, len(slice))
 
+	// In strict mode, every invalid element is reported
+	var elementErrors ElementErrors
+
 	for i, item := range slice {
 		switch v := item.(type) {
 		case map[string]interface{}:
@@ -113,28 +149,43 @@ func (f *SliceField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 			if f.callback != nil {
 				f.callback(subSchema, &element)
 			}
-			if err := subSchema.Apply(v); err != nil {
-				return fmt.Errorf("element %d: %v", i, err)
+			if err := subSchema.Apply(v, schema.subSchemaOptions(f.name)...); err != nil {
+				return ElementErrors{{Index: i, Error: err}}
 			}
 			result[i] = element
 		default:
+			if f.strictNumbers {
+				if err := checkPlainDecimal[T](v); err != nil {
+					return ElementErrors{{Index: i, Error: err}}
+				}
+			}
+
+			if f.strict {
+				converted, err := convertStrict[T](v)
+				if err != nil {
+					elementErrors = append(elementErrors, ElementError{Index: i, Error: err})
+					continue
+				}
+				result[i] = converted
+				continue
+			}
+
 			converted, err := convertValue[T](v)
 			if err != nil {
-				return fmt.Errorf("element %d: %v", i, err)
+				return ElementErrors{{Index: i, Error: err}}
 			}
 			result[i] = converted
 		}
 	}
 
+	if len(elementErrors) > 0 {
+		return elementErrors
+	}
+
 	// Apply transformers
-	transformed := result
-	for _, transformer := range f.transformers {
-		var err error
-		transformed, err = transformer.Transform(transformed)
-		if err != nil {
-			return fmt.Errorf("transformer failed: %v", err)
-		}
-		result = transformed
+	result, err := applyTransformers(f.transformers, result, f.name, schema)
+	if err != nil {
+		return fmt.Errorf("transformer failed: %v", err)
 	}
 
 	*f.ptr = result
@@ -143,6 +194,23 @@ func (f *SliceField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *SliceField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *SliceField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *SliceField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*[]T)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *SliceField[T]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
@@ -153,6 +221,25 @@ func (f *SliceField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *SliceField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *SliceField[T]) describeField(info *FieldInfo) {
+	info.Type = "[]" + typeName[T]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+	if f.callback != nil {
+		subSchema := NewSchema()
+		f.callback(subSchema, new(T))
+		info.Fields = subSchema.Describe()
+	}
+}
+
 // SetCallback sets the callback function for configuring sub-schemas
 func (f *SliceField[T]) SetCallback(callback func(*Schema, *T)) {
 	f.callback = callback
@@ -0,0 +1,86 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSliceField_Strict(t *testing.T) {
+	t.Run("coerces by default", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(Slice("ids", &ids))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"ids": []interface{}{1.0, "2"}}))
+		assert.Equal(t, []int{1, 2}, ids)
+	})
+
+	t.Run("strict integers", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(Slice("ids", &ids, Strict()))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"ids": []interface{}{1.0, 2, json.Number("3")}}))
+		assert.Equal(t, []int{1, 2, 3}, ids)
+
+		err := schema.Apply(map[string]interface{}{"ids": []interface{}{1, "2", true, 3.9}})
+		assert.EqualError(t, err, "ids: element 1: expected int, got string; element 2: expected int, got bool; element 3: expected int, got 3.9")
+	})
+
+	t.Run("strict strings", func(t *testing.T) {
+		var tags []string
+		schema := NewSchema(Slice("tags", &tags, Strict()))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"tags": []interface{}{"a", "b"}}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"tags": []interface{}{"a", 1.0}}), "tags: element 1: expected string, got float64")
+	})
+
+	t.Run("strict floats accept integers", func(t *testing.T) {
+		var values []float64
+		schema := NewSchema(Slice("values", &values, Strict()))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"values": []interface{}{1, 2.5}}))
+		assert.Equal(t, []float64{1, 2.5}, values)
+	})
+}
+
+func TestWithMaxElements(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		var ids []int
+		schema := NewSchema(Slice("ids", &ids, WithMaxElements(2)))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"ids": []interface{}{1, 2}}))
+		assert.Equal(t, []int{1, 2}, ids)
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"ids": []interface{}{1, 2, 3}}), "ids: must have at most 2 items")
+	})
+
+	t.Run("slice of structs is rejected before building sub-schemas", func(t *testing.T) {
+		type Item struct{ Name string }
+		var items []Item
+		calls := 0
+		schema := NewSchema(Slice("items", &items, WithMaxElements(1), WithSubSchema(func(s *Schema, item *Item) {
+			calls++
+			WithSchema(s, Value("name", &item.Name))
+		})))
+		calls = 0
+
+		err := schema.Apply(map[string]interface{}{"items": []map[string]interface{}{{"name": "a"}, {"name": "b"}}})
+		assert.EqualError(t, err, "items: must have at most 1 items")
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		var settings map[string]string
+		schema := NewSchema(Map("settings", &settings, WithMaxElements(1)))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"settings": map[string]interface{}{"a": "1"}}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"settings": map[string]interface{}{"a": "1", "b": "2"}}), "settings: must have at most 1 items")
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		var name string
+		assert.Panics(t, func() { Value("name", &name, WithMaxElements(1)) })
+	})
+}
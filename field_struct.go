@@ -12,6 +12,7 @@ type StructField[T any] struct {
 	callback     func(*Schema, *T)
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue T
 	hasDefault   bool
 }
@@ -46,12 +47,16 @@ func (f *StructField[T]) SetDescription(description string) {
 
 // Assign assigns a value to the field from the input data
 func (f *StructField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
 		if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 
@@ -78,7 +83,24 @@ func (f *StructField[T]) Assign(data map[string]interface{}, schema *Schema) err
 	f.callback(subSchema, f.ptr)
 	f.wasAssigned = true
 
-	return subSchema.Apply(structData)
+	return subSchema.Apply(structData, schema.subSchemaOptions(f.name)...)
+}
+
+// assignState implements assignStateReporter interface
+func (f *StructField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *StructField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *StructField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*T)
+	return &clone
 }
 
 // Validate validates the field value using all registered validators
@@ -91,6 +113,25 @@ func (f *StructField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *StructField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *StructField[T]) describeField(info *FieldInfo) {
+	info.Type = typeName[T]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+	if f.callback != nil {
+		subSchema := NewSchema()
+		f.callback(subSchema, new(T))
+		info.Fields = subSchema.Describe()
+	}
+}
+
 // SetCallback sets the callback function for configuring sub-schemas
 func (f *StructField[T]) SetCallback(callback func(*Schema, *T)) {
 	f.callback = callback
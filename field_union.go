@@ -11,7 +11,8 @@ type UnionField struct {
 	description string
 	ptr         interface{}
 	resolver    func(map[string]interface{}) (interface{}, error)
-	wasAssigned bool // Track if a non-nil value was assigned
+	set         func(ptr, result interface{}) error // Stores the resolved value into ptr
+	wasAssigned bool                                // Track if a non-nil value was assigned
 }
 
 // Name returns the field name
@@ -44,6 +45,8 @@ func (f *UnionField) Value() interface{} {
 
 // Assign assigns a value to the field from the input data
 func (f *UnionField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		return nil
@@ -67,29 +70,90 @@ func (f *UnionField) Assign(data map[string]interface{}, schema *Schema) error {
 		return err
 	}
 
-	// Assign the result to the pointer
-	ptrValue := reflect.ValueOf(f.ptr)
-	if ptrValue.Kind() != reflect.Ptr || ptrValue.Elem().Kind() != reflect.Interface {
-		return fmt.Errorf("union field pointer must be pointer to interface")
+	if err := f.set(f.ptr, result); err != nil {
+		return err
 	}
-
-	ptrValue.Elem().Set(reflect.ValueOf(result))
 	f.wasAssigned = true
 
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *UnionField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface
+func (f *UnionField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *UnionField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *UnionField) Validate(schema *Schema) error {
 	// Validation happens during assignment
 	return nil
 }
 
-// Union creates a union field
+// describeField implements fieldDescriber interface
+func (f *UnionField) describeField(info *FieldInfo) {
+	if ptrType := reflect.TypeOf(f.ptr); ptrType != nil && ptrType.Kind() == reflect.Ptr {
+		info.Type = ptrType.Elem().String()
+	}
+}
+
+// setInterface stores a resolved value into a pointer to an interface, using reflection
+// unless the pointer is a plain *interface{}
+func setInterface(ptr interface{}, result interface{}) error {
+	if p, ok := ptr.(*interface{}); ok {
+		*p = result
+		return nil
+	}
+
+	ptrValue := reflect.ValueOf(ptr)
+	if ptrValue.Kind() != reflect.Ptr || ptrValue.Elem().Kind() != reflect.Interface {
+		return fmt.Errorf("union field pointer must be pointer to interface")
+	}
+
+	resultValue := reflect.ValueOf(result)
+	if !resultValue.IsValid() || !resultValue.Type().AssignableTo(ptrValue.Elem().Type()) {
+		return fmt.Errorf("union resolver returned %T, which doesn't implement %s", result, ptrValue.Elem().Type())
+	}
+
+	ptrValue.Elem().Set(resultValue)
+	return nil
+}
+
+// Union creates a union field.
+// ptr must be a pointer to an interface; prefer UnionOf, which checks the types at compile time.
 func Union(name string, ptr interface{}, resolver func(map[string]interface{}) (interface{}, error)) Field {
 	return &UnionField{
 		name:     name,
 		ptr:      ptr,
 		resolver: resolver,
+		set:      setInterface,
+	}
+}
+
+// UnionOf creates a union field whose resolver returns the interface type of the pointer.
+// The resolved value is assigned without reflection.
+func UnionOf[T any](name string, ptr *T, resolver func(map[string]interface{}) (T, error)) Field {
+	return &UnionField{
+		name: name,
+		ptr:  ptr,
+		resolver: func(data map[string]interface{}) (interface{}, error) {
+			return resolver(data)
+		},
+		set: func(ptr, result interface{}) error {
+			typed, _ := result.(T) // a nil interface resolves to the zero value
+			*ptr.(*T) = typed
+			return nil
+		},
 	}
 }
@@ -0,0 +1,62 @@
+package poxxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type unionShape interface{ Area() float64 }
+
+type unionSquare struct{ Side float64 }
+
+func (s unionSquare) Area() float64 { return s.Side * s.Side }
+
+func resolveUnionShape(data map[string]interface{}) (unionShape, error) {
+	switch data["type"] {
+	case "square":
+		var square unionSquare
+		if err := NewSchema(Value("side", &square.Side)).Apply(data); err != nil {
+			return nil, err
+		}
+		return square, nil
+	default:
+		return nil, fmt.Errorf("unknown shape %v", data["type"])
+	}
+}
+
+func TestUnionField(t *testing.T) {
+	input := map[string]interface{}{"shape": map[string]interface{}{"type": "square", "side": 2.0}}
+
+	t.Run("Union", func(t *testing.T) {
+		var shape unionShape
+		schema := NewSchema(Union("shape", &shape, func(data map[string]interface{}) (interface{}, error) {
+			return resolveUnionShape(data)
+		}))
+
+		require.NoError(t, schema.Apply(input))
+		assert.Equal(t, unionSquare{Side: 2}, shape)
+	})
+
+	t.Run("Union rejects values not implementing the interface", func(t *testing.T) {
+		var shape unionShape
+		schema := NewSchema(Union("shape", &shape, func(data map[string]interface{}) (interface{}, error) {
+			return "square", nil
+		}))
+
+		assert.EqualError(t, schema.Apply(input), "shape: union resolver returned string, which doesn't implement poxxy.unionShape")
+	})
+
+	t.Run("UnionOf", func(t *testing.T) {
+		var shape unionShape
+		schema := NewSchema(UnionOf("shape", &shape, resolveUnionShape))
+
+		require.NoError(t, schema.Apply(input))
+		assert.Equal(t, 4.0, shape.Area())
+
+		err := schema.Apply(map[string]interface{}{"shape": map[string]interface{}{"type": "circle"}})
+		assert.EqualError(t, err, "shape: unknown shape circle")
+	})
+}
@@ -0,0 +1,248 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// URLListField represents a list of URLs, e.g. the callbacks of a webhook subscription
+type URLListField struct {
+	name         string
+	description  string
+	ptr          *[]string
+	Validators   []Validator
+	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
+	defaultValue []string
+	hasDefault   bool
+	maxElements  int // Maximum number of URLs accepted, 0 means unlimited
+	policy       urlPolicy
+}
+
+// Name returns the field name
+func (f *URLListField) Name() string {
+	return f.name
+}
+
+// Value returns the current value of the field
+func (f *URLListField) Value() interface{} {
+	if !f.wasAssigned {
+		return nil
+	}
+
+	return *f.ptr
+}
+
+// Description returns the field description
+func (f *URLListField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the field description
+func (f *URLListField) SetDescription(description string) {
+	f.description = description
+}
+
+// SetDefaultValue sets the default value for the field
+func (f *URLListField) SetDefaultValue(defaultValue []string) {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+}
+
+// SetMaxElements sets the maximum number of URLs accepted by the field
+func (f *URLListField) SetMaxElements(n int) {
+	f.maxElements = n
+}
+
+// SetSchemes sets the schemes accepted by the field
+func (f *URLListField) SetSchemes(schemes []string) {
+	f.policy.schemes = schemes
+}
+
+// SetDenyPrivateHosts makes the field reject URLs pointing to a private host
+func (f *URLListField) SetDenyPrivateHosts(deny bool) {
+	f.policy.denyPrivateHosts = deny
+}
+
+// acceptsMultipleValues implements multiValueField interface
+func (f *URLListField) acceptsMultipleValues() bool {
+	return true
+}
+
+// Assign assigns a value to the field from the input data.
+// Strings are split on commas and whitespace; URLs are trimmed and empty elements are skipped.
+func (f *URLListField) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
+	value, exists := data[f.name]
+	if !exists || isEmpty(value) {
+		// Apply default value if available
+		if f.hasDefault {
+			*f.ptr = f.defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		}
+
+		return nil
+	}
+	schema.SetFieldPresent(f.name)
+
+	var urls []string
+	switch v := value.(type) {
+	case string:
+		urls = splitURLs(v)
+	case []string:
+		// Repeated keys, e.g. "callback=https://a.example&callback=https://b.example"
+		for _, str := range v {
+			urls = append(urls, splitURLs(str)...)
+		}
+	case []interface{}:
+		// JSON array, elements are not split as URLs may contain commas
+		for i, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return ElementErrors{{Index: i, Error: fmt.Errorf("expected a URL, got %T", item)}}
+			}
+			if str = strings.TrimSpace(str); str != "" {
+				urls = append(urls, str)
+			}
+		}
+	default:
+		return fmt.Errorf("expected a list of URLs, got %T", value)
+	}
+
+	if f.maxElements > 0 {
+		if err := checkMaxElements(len(urls), f.maxElements); err != nil {
+			return err
+		}
+	}
+
+	*f.ptr = urls
+	f.wasAssigned = true
+	return nil
+}
+
+// splitURLs splits a string on commas and whitespace, skipping empty elements
+func splitURLs(str string) []string {
+	return strings.FieldsFunc(str, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+}
+
+// assignState implements assignStateReporter interface
+func (f *URLListField) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *URLListField) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *URLListField) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*[]string)
+	return &clone
+}
+
+// Validate checks every URL against the scheme and host policy of the field, reporting all
+// the invalid ones with their index, then runs the validators of the field
+func (f *URLListField) Validate(schema *Schema) error {
+	if f.wasAssigned {
+		var errs ElementErrors
+		for i, str := range *f.ptr {
+			if _, err := f.policy.check(str); err != nil {
+				errs = append(errs, ElementError{Index: i, Error: err})
+			}
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+
+	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
+}
+
+// AppendValidators implements ValidatorsAppender interface
+func (f *URLListField) AppendValidators(validators []Validator) {
+	f.Validators = append(f.Validators, validators...)
+}
+
+// GetValidators implements ValidatorsGetter interface
+func (f *URLListField) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *URLListField) describeField(info *FieldInfo) {
+	info.Type = "[]string"
+	each := ConstraintInfo{Kind: "each", Params: map[string]interface{}{"validators": []ConstraintInfo{f.policy.describe()}}}
+	info.Constraints = append([]ConstraintInfo{each}, info.Constraints...)
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
+// SchemesOption holds the schemes accepted by a URL field
+type SchemesOption struct {
+	schemes []string
+}
+
+// Apply applies the schemes to the field
+func (o SchemesOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetSchemes([]string) }); ok {
+		f.SetSchemes(o.schemes)
+	} else {
+		panic(fmt.Sprintf("Schemes doesn't support %T", field))
+	}
+}
+
+// Schemes sets the schemes accepted by a URLList field (http and https by default).
+// Schemes are compared case-insensitively.
+func Schemes(schemes ...string) Option {
+	return SchemesOption{schemes: schemes}
+}
+
+// DenyPrivateHostsOption holds whether a URL field rejects private hosts
+type DenyPrivateHostsOption struct {
+	deny bool
+}
+
+// Apply applies the private host policy to the field
+func (o DenyPrivateHostsOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetDenyPrivateHosts(bool) }); ok {
+		f.SetDenyPrivateHosts(o.deny)
+	} else {
+		panic(fmt.Sprintf("DenyPrivateHosts doesn't support %T", field))
+	}
+}
+
+// DenyPrivateHosts makes a URLList field reject URLs pointing to the local machine or a private network
+// (localhost, private and link-local addresses, ...), to protect server-side requests against SSRF.
+// Host names are not resolved: the HTTP client must also refuse private addresses when connecting.
+func DenyPrivateHosts() Option {
+	return DenyPrivateHostsOption{deny: true}
+}
+
+// URLList creates a field binding a list of absolute URLs, e.g. webhook callbacks.
+// It accepts a JSON array or a comma or whitespace separated string. Every URL is checked against
+// the accepted schemes and, with DenyPrivateHosts, must not point to a private host; all the invalid
+// URLs are reported with their index:
+//
+//	poxxy.URLList("callbacks", &callbacks, poxxy.Schemes("https"), poxxy.DenyPrivateHosts(), poxxy.WithMaxElements(10))
+func URLList(name string, ptr *[]string, opts ...Option) Field {
+	field := &URLListField{
+		name: name,
+		ptr:  ptr,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(field)
+	}
+
+	return field
+}
@@ -0,0 +1,52 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLListField(t *testing.T) {
+	t.Run("separated string and json array", func(t *testing.T) {
+		var callbacks []string
+		schema := NewSchema(URLList("callbacks", &callbacks))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"callbacks": "https://a.example.com/hook, http://b.example.com\nhttps://c.example.com"}))
+		assert.Equal(t, []string{"https://a.example.com/hook", "http://b.example.com", "https://c.example.com"}, callbacks)
+
+		require.NoError(t, schema.ApplyJSON([]byte(`{"callbacks": ["https://a.example.com/?tags=a,b", " "]}`)))
+		assert.Equal(t, []string{"https://a.example.com/?tags=a,b"}, callbacks)
+	})
+
+	t.Run("scheme and private host policy", func(t *testing.T) {
+		var callbacks []string
+		schema := NewSchema(URLList("callbacks", &callbacks, Schemes("https"), DenyPrivateHosts()))
+
+		err := schema.ApplyJSON([]byte(`{"callbacks": [
+			"https://hooks.example.com/a",
+			"http://hooks.example.com/b",
+			"https://localhost:8080/c",
+			"https://10.0.0.12/d",
+			"not a url",
+			"HTTPS://8.8.8.8/e"
+		]}`))
+		require.Error(t, err)
+		assert.EqualError(t, err, "callbacks: element 1: URL scheme must be one of https; element 2: URL must not point to a private host; element 3: URL must not point to a private host; element 4: invalid URL format")
+	})
+
+	t.Run("default schemes", func(t *testing.T) {
+		var callbacks []string
+		schema := NewSchema(URLList("callbacks", &callbacks))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"callbacks": "http://localhost:3000"}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"callbacks": "ftp://example.com"}), "callbacks: element 0: URL scheme must be one of http, https")
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		var callbacks []string
+		schema := NewSchema(URLList("callbacks", &callbacks, Schemes("https"), DenyPrivateHosts()))
+
+		assert.Equal(t, "each(url(deny_private_hosts=true, schemes=[https]))", formatConstraints(schema.Describe()[0].Constraints))
+	})
+}
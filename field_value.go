@@ -3,6 +3,7 @@ package poxxy
 import (
 	"database/sql/driver"
 	"reflect"
+	"time"
 )
 
 // ValueField represents a basic value field
@@ -12,9 +13,16 @@ type ValueField[T any] struct {
 	ptr          *T
 	Validators   []Validator
 	wasAssigned  bool // Track if a non-nil value was assigned
+	defaulted    bool // Track if the default value was applied
 	defaultValue T
 	hasDefault   bool
+	defaultFunc  func(*Schema) (T, error)
+	dependencies []string
 	transformers []Transformer[T]
+	// Reject numeric strings not in plain decimal notation
+	strictNumbers bool
+	// Time layouts and duration unit of the input (see WithTimeLayouts and WithDurationUnit)
+	conversion conversionOptions
 }
 
 // Name returns the field name
@@ -67,14 +75,57 @@ func (f *ValueField[T]) SetDefaultValue(defaultValue T) {
 	f.hasDefault = true
 }
 
+// SetDefaultFunc sets a function computing the default value of the field from the schema
+func (f *ValueField[T]) SetDefaultFunc(defaultFunc func(*Schema) (T, error)) {
+	f.defaultFunc = defaultFunc
+}
+
+// SetStrictNumericStrings makes the field reject numeric strings not in plain decimal notation
+func (f *ValueField[T]) SetStrictNumericStrings(strict bool) {
+	f.strictNumbers = strict
+}
+
+// SetTimeLayouts sets the layouts of the time strings accepted by the field
+func (f *ValueField[T]) SetTimeLayouts(layouts []string) {
+	f.conversion.timeLayouts = layouts
+}
+
+// SetDurationUnit sets the unit of the integer durations accepted by the field
+func (f *ValueField[T]) SetDurationUnit(unit time.Duration) {
+	f.conversion.durationUnit = unit
+}
+
+// AppendDependencies implements DependenciesAppender interface
+func (f *ValueField[T]) AppendDependencies(fieldNames []string) {
+	f.dependencies = append(f.dependencies, fieldNames...)
+}
+
+// GetDependencies implements DependenciesGetter interface
+func (f *ValueField[T]) GetDependencies() []string {
+	return f.dependencies
+}
+
 // Assign assigns a value to the field from the input data
 func (f *ValueField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.wasAssigned = false
+	f.defaulted = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		// Apply default value if available
-		if f.hasDefault {
+		if f.defaultFunc != nil {
+			defaultValue, err := f.defaultFunc(schema)
+			if err != nil {
+				return err
+			}
+			*f.ptr = defaultValue
+			f.wasAssigned = true
+			f.defaulted = true
+			schema.SetFieldPresent(f.name)
+		} else if f.hasDefault {
 			*f.ptr = f.defaultValue
 			f.wasAssigned = true
+			f.defaulted = true
 			schema.SetFieldPresent(f.name)
 		}
 		return nil // Will be caught by Required validator if needed
@@ -95,19 +146,22 @@ func (f *ValueField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 		return nil
 	}
 
+	if f.strictNumbers {
+		if err := checkPlainDecimal[T](value); err != nil {
+			return err
+		}
+	}
+
 	// Type conversion
-	converted, err := convertValue[T](value)
+	converted, err := convertFieldValue[T](value, f.conversion)
 	if err != nil {
 		return err
 	}
 
 	// Apply transformers
-	transformed := converted
-	for _, transformer := range f.transformers {
-		transformed, err = transformer.Transform(transformed)
-		if err != nil {
-			return err
-		}
+	transformed, err := applyTransformers(f.transformers, converted, f.name, schema)
+	if err != nil {
+		return err
 	}
 
 	*f.ptr = transformed
@@ -115,6 +169,23 @@ func (f *ValueField[T]) Assign(data map[string]interface{}, schema *Schema) erro
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *ValueField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, f.defaulted
+}
+
+// target implements boundField interface
+func (f *ValueField[T]) target() interface{} {
+	return f.ptr
+}
+
+// bind implements boundField interface
+func (f *ValueField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	clone.ptr = ptr.(*T)
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *ValueField[T]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, *f.ptr, f.name, schema)
@@ -125,6 +196,20 @@ func (f *ValueField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *ValueField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ValueField[T]) describeField(info *FieldInfo) {
+	info.Type = typeName[T]()
+	if f.hasDefault {
+		info.HasDefault = true
+		info.Default = f.defaultValue
+	}
+}
+
 // Value creates a value field
 func Value[T any](name string, ptr *T, opts ...Option) Field {
 	field := &ValueField[T]{
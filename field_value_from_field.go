@@ -55,6 +55,9 @@ func (f *ValueWithoutAssignField[T]) Value() interface{} {
 
 // Assign assigns a value to the field from the input data
 func (f *ValueWithoutAssignField[T]) Assign(data map[string]interface{}, schema *Schema) error {
+	f.value = nil
+	f.wasAssigned = false
+
 	value, exists := data[f.name]
 	if !exists || isEmpty(value) {
 		return nil
@@ -77,6 +80,22 @@ func (f *ValueWithoutAssignField[T]) Assign(data map[string]interface{}, schema
 	return nil
 }
 
+// assignState implements assignStateReporter interface
+func (f *ValueWithoutAssignField[T]) assignState() (assigned bool, defaulted bool) {
+	return f.wasAssigned, false
+}
+
+// target implements boundField interface, the field doesn't assign any variable
+func (f *ValueWithoutAssignField[T]) target() interface{} {
+	return nil
+}
+
+// bind implements boundField interface
+func (f *ValueWithoutAssignField[T]) bind(ptr interface{}) Field {
+	clone := *f
+	return &clone
+}
+
 // Validate validates the field value using all registered validators
 func (f *ValueWithoutAssignField[T]) Validate(schema *Schema) error {
 	return validateFieldValidators(f.Validators, f.value, f.name, schema)
@@ -87,6 +106,16 @@ func (f *ValueWithoutAssignField[T]) AppendValidators(validators []Validator) {
 	f.Validators = append(f.Validators, validators...)
 }
 
+// GetValidators implements ValidatorsGetter interface
+func (f *ValueWithoutAssignField[T]) GetValidators() []Validator {
+	return f.Validators
+}
+
+// describeField implements fieldDescriber interface
+func (f *ValueWithoutAssignField[T]) describeField(info *FieldInfo) {
+	info.Type = typeName[T]()
+}
+
 // ValueWithoutAssign validates a direct value (used in map validation)
 func ValueWithoutAssign[T any](name string, opts ...Option) Field {
 	field := &ValueWithoutAssignField[T]{
@@ -0,0 +1,103 @@
+package poxxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// multiValueField is implemented by fields receiving every value of a repeated form key
+type multiValueField interface {
+	acceptsMultipleValues() bool
+}
+
+// acceptsMultipleValues implements multiValueField interface
+func (f *SliceField[T]) acceptsMultipleValues() bool {
+	return true
+}
+
+// acceptsMultipleValues implements multiValueField interface
+func (f *ArrayField[T]) acceptsMultipleValues() bool {
+	return true
+}
+
+// ApplyURLValues assigns data from already-parsed form or query values to a schema.
+// Slice and array fields receive every value of their key (e.g. "tags=a&tags=b"), as do keys
+// using the "tags[]" convention (after the values of "tags") and the keys of Filters fields;
+// other fields receive the first value.
+func (s *Schema) ApplyURLValues(values url.Values, options ...SchemaOption) error {
+	return s.Apply(s.urlValuesData(values), options...)
+}
+
+// ApplyForm assigns the form data of an HTTP request to a schema.
+// The form is parsed with r.ParseForm unless it was already parsed by the caller
+// (e.g. with r.ParseMultipartForm). Like ApplyHTTPRequest, only the body values are used,
+// not the URL query parameters.
+func (s *Schema) ApplyForm(r *http.Request, options ...SchemaOption) error {
+	if r.PostForm == nil {
+		if err := r.ParseForm(); err != nil {
+			return fmt.Errorf("failed to parse form: %w", err)
+		}
+	}
+
+	return s.ApplyURLValues(r.PostForm, append([]SchemaOption{WithRequestHeader(r.Header)}, options...)...)
+}
+
+// urlValuesData converts url.Values to the data map given to Apply
+func (s *Schema) urlValuesData(values url.Values) map[string]interface{} {
+	// Sorted keys merge "tags" values before "tags[]" ones, in a stable order
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := make(map[string]interface{}, len(values))
+	for _, key := range keys {
+		vals := values[key]
+		if len(vals) == 0 {
+			continue
+		}
+
+		name := strings.TrimSuffix(key, "[]")
+		if name == key && !s.acceptsMultipleValues(name) && !s.isFilterKey(key) {
+			data[key] = vals[0]
+			continue
+		}
+
+		// Merge "tags" and "tags[]" values
+		existing, _ := data[name].([]string)
+		data[name] = append(existing, vals...)
+	}
+
+	return data
+}
+
+// isFilterKey reports whether key is a bracketed key of a Filters field (e.g. "filter[id][in]"), which
+// receives every value of a repeated key
+func (s *Schema) isFilterKey(key string) bool {
+	for _, field := range s.fields {
+		if filter, ok := field.(*FilterField); ok && strings.HasPrefix(key, filter.name+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// acceptsMultipleValues reports whether the field with the given name receives every value of a repeated key
+func (s *Schema) acceptsMultipleValues(fieldName string) bool {
+	for _, field := range s.fields {
+		if field.Name() != fieldName {
+			continue
+		}
+
+		if multi, ok := field.(multiValueField); ok && multi.acceptsMultipleValues() {
+			return true
+		}
+	}
+
+	return false
+}
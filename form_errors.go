@@ -0,0 +1,123 @@
+package poxxy
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// FormErrors maps field names to their error messages, for re-rendering server-side HTML forms.
+// Fields of sub-schemas and elements of slices use dotted names (e.g. "address.zip", "items.0.name");
+// errors not attributed to a field are stored under the empty name.
+type FormErrors map[string][]string
+
+// NewFormErrors returns the messages of the validation errors returned by Apply, or nil when err is nil.
+// Secondary errors are left out (see FieldError.Secondary):
+//
+//	if err := schema.ApplyForm(r); err != nil {
+//		tmpl.Execute(w, map[string]interface{}{
+//			"Errors": poxxy.NewFormErrors(err),
+//			"Old":    schema.OldInput("password"),
+//		})
+//	}
+func NewFormErrors(err error) FormErrors {
+	if err == nil {
+		return nil
+	}
+
+	formErrors := make(FormErrors)
+	var fieldErrors Errors
+	if !errors.As(err, &fieldErrors) {
+		formErrors[""] = []string{err.Error()}
+		return formErrors
+	}
+
+	for _, fieldError := range fieldErrors.Flatten().Primary() {
+		name := formName(fieldError.Path)
+		formErrors[name] = append(formErrors[name], fieldError.Error.Error())
+	}
+
+	return formErrors
+}
+
+// formName returns the dotted form name of a path (e.g. "items.0.name")
+func formName(path Path) string {
+	var name string
+	for _, element := range path {
+		name = joinFormName(name, fmt.Sprint(element))
+	}
+
+	return name
+}
+
+// Has reports whether the field has errors, e.g. to add an "is-invalid" class
+func (e FormErrors) Has(field string) bool {
+	return len(e[field]) > 0
+}
+
+// First returns the first error message of the field, or "" if it has none
+func (e FormErrors) First(field string) string {
+	if len(e[field]) == 0 {
+		return ""
+	}
+
+	return e[field][0]
+}
+
+// OldInput returns the input of the last Apply as form values, to re-render an HTML form with the values
+// the user typed ("sticky" values), including the invalid ones. Nested objects and lists use dotted names
+// like NewFormErrors. The except fields (e.g. "password") are left out so secrets are never echoed back.
+func (s *Schema) OldInput(except ...string) url.Values {
+	excluded := make(map[string]bool, len(except))
+	for _, name := range except {
+		excluded[name] = true
+	}
+
+	values := make(url.Values)
+	for key, value := range s.data {
+		addOldInput(values, key, value, excluded)
+	}
+
+	return values
+}
+
+// addOldInput adds the string representation of an input value to values
+func addOldInput(values url.Values, name string, value interface{}, excluded map[string]bool) {
+	if excluded[name] {
+		return
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case string:
+		values.Add(name, v)
+	case []string:
+		for _, str := range v {
+			values.Add(name, str)
+		}
+	case []interface{}:
+		for i, element := range v {
+			if _, ok := element.(map[string]interface{}); ok {
+				addOldInput(values, joinFormName(name, strconv.Itoa(i)), element, excluded)
+				continue
+			}
+			addOldInput(values, name, element, excluded)
+		}
+	case map[string]interface{}:
+		for key, element := range v {
+			addOldInput(values, joinFormName(name, key), element, excluded)
+		}
+	default:
+		values.Add(name, fmt.Sprint(v))
+	}
+}
+
+// joinFormName joins a parent and a child form name with a dot
+func joinFormName(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+
+	return parent + "." + child
+}
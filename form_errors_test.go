@@ -0,0 +1,68 @@
+package poxxy
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormErrors(t *testing.T) {
+	type Address struct {
+		Zip string
+	}
+
+	var name, password string
+	var age int
+	var tags []string
+	var address Address
+
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required())),
+		Value("password", &password, WithValidators(MinLength(8))),
+		Value("age", &age, WithValidators(Min(18))),
+		Slice("tags", &tags, WithValidators(EachAll(MaxLength(3)))),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("zip", &a.Zip, WithValidators(Required(), MinLength(5))))
+		})),
+	)
+
+	err := schema.Apply(map[string]interface{}{
+		"password": "secret",
+		"age":      "12",
+		"tags":     []interface{}{"go", "python"},
+		"address":  map[string]interface{}{"zip": "123"},
+	})
+	require.Error(t, err)
+
+	formErrors := NewFormErrors(err)
+	assert.Equal(t, FormErrors{
+		"name":        {"field is required"},
+		"password":    {"must be at least 8 characters long"},
+		"age":         {"value must be at least 18"},
+		"tags.1":      {"must be at most 3 characters long"},
+		"address.zip": {"must be at least 5 characters long"},
+	}, formErrors)
+	assert.True(t, formErrors.Has("age"))
+	assert.False(t, formErrors.Has("tags"))
+	assert.Equal(t, "value must be at least 18", formErrors.First("age"))
+	assert.Empty(t, formErrors.First("unknown"))
+
+	assert.Equal(t, url.Values{
+		"age":         {"12"},
+		"tags":        {"go", "python"},
+		"address.zip": {"123"},
+	}, schema.OldInput("password"))
+
+	t.Run("other errors", func(t *testing.T) {
+		assert.Nil(t, NewFormErrors(nil))
+		assert.Equal(t, FormErrors{"": {"failed to parse form"}}, NewFormErrors(errors.New("failed to parse form")))
+	})
+
+	t.Run("form values", func(t *testing.T) {
+		require.Error(t, schema.ApplyURLValues(url.Values{"name": {""}, "tags": {"a", "b"}, "age": {"20"}}))
+		assert.Equal(t, url.Values{"name": {""}, "tags": {"a", "b"}, "age": {"20"}}, schema.OldInput())
+	})
+}
@@ -0,0 +1,87 @@
+package poxxy
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_ApplyURLValues(t *testing.T) {
+	var name string
+	var tags []string
+	var ids []int
+	var pair [2]string
+
+	schema := NewSchema(
+		Value("name", &name),
+		Slice("tags", &tags),
+		Slice("ids", &ids),
+		Array[string]("pair", &pair),
+	)
+
+	values := url.Values{
+		"name":  {"John", "Jane"},
+		"tags":  {"go"},
+		"ids[]": {"1", "2"},
+		"ids":   {"3"},
+		"pair":  {"a", "b"},
+	}
+
+	require.NoError(t, schema.ApplyURLValues(values))
+	assert.Equal(t, "John", name)
+	assert.Equal(t, []string{"go"}, tags)
+	assert.Equal(t, []int{3, 1, 2}, ids, `"ids" values come before "ids[]" ones`)
+	assert.Equal(t, [2]string{"a", "b"}, pair)
+}
+
+func TestSchema_ApplyForm(t *testing.T) {
+	var name string
+	var tags []string
+
+	newSchema := func() *Schema {
+		return NewSchema(
+			Value("name", &name, WithValidators(Required())),
+			Slice("tags", &tags),
+		)
+	}
+
+	t.Run("urlencoded form", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/?name=query", strings.NewReader("name=John&tags=a&tags=b"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		require.NoError(t, newSchema().ApplyForm(r))
+		assert.Equal(t, "John", name)
+		assert.Equal(t, []string{"a", "b"}, tags)
+	})
+
+	t.Run("already parsed multipart form", func(t *testing.T) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		require.NoError(t, writer.WriteField("name", "Jane"))
+		require.NoError(t, writer.WriteField("tags", "x"))
+		require.NoError(t, writer.WriteField("tags", "y"))
+		require.NoError(t, writer.Close())
+
+		r := httptest.NewRequest(http.MethodPost, "/", &body)
+		r.Header.Set("Content-Type", writer.FormDataContentType())
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		require.NoError(t, newSchema().ApplyForm(r))
+		assert.Equal(t, "Jane", name)
+		assert.Equal(t, []string{"x", "y"}, tags)
+	})
+
+	t.Run("repeated query keys with ApplyHTTPRequest", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/?name=John&tags=a&tags=b", nil)
+
+		require.NoError(t, newSchema().ApplyHTTPRequest(nil, r, nil))
+		assert.Equal(t, []string{"a", "b"}, tags)
+	})
+}
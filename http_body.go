@@ -0,0 +1,60 @@
+package poxxy
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// limitRequestBody limits the size of the request body and, when enabled, decompresses it.
+// The bytes received are copied to capture when it is not nil.
+func limitRequestBody(w http.ResponseWriter, r *http.Request, opt *HTTPRequestOption, capture io.Writer) error {
+	if opt.MaxRequestBodySize > 0 {
+		// Limit the request body size
+		r.Body = http.MaxBytesReader(w, r.Body, opt.MaxRequestBodySize)
+	}
+
+	if capture != nil {
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.TeeReader(r.Body, capture), r.Body}
+	}
+
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	if !opt.Decompress || encoding == "" || encoding == "identity" {
+		return nil
+	}
+
+	var decompressed io.ReadCloser
+	switch encoding {
+	case "gzip", "x-gzip":
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decompress request body: %w", err)
+		}
+		decompressed = reader
+	case "deflate":
+		reader, err := zlib.NewReader(r.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decompress request body: %w", err)
+		}
+		decompressed = reader
+	default:
+		return fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	maxSize := opt.MaxDecompressedBodySize
+	if maxSize <= 0 {
+		maxSize = MaxBodySize
+	}
+
+	r.Body = http.MaxBytesReader(w, decompressed, maxSize)
+	r.Header.Del("Content-Encoding")
+	r.ContentLength = -1
+
+	return nil
+}
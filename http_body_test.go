@@ -0,0 +1,135 @@
+package poxxy
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_ApplyHTTPRequest_Decompress(t *testing.T) {
+	compress := func(encoding, body string) io.Reader {
+		var buf bytes.Buffer
+		var w io.WriteCloser
+		if encoding == "gzip" {
+			w = gzip.NewWriter(&buf)
+		} else {
+			w = zlib.NewWriter(&buf)
+		}
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return &buf
+	}
+
+	newRequest := func(encoding string, body io.Reader) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", body)
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Content-Encoding", encoding)
+		return r
+	}
+
+	var name string
+	schema := NewSchema(Value("name", &name))
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		t.Run(encoding, func(t *testing.T) {
+			name = ""
+			r := newRequest(encoding, compress(encoding, `{"name": "John"}`))
+
+			require.NoError(t, schema.ApplyHTTPRequest(nil, r, &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingAuto, MaxRequestBodySize: 1 << 10, Decompress: true}))
+			assert.Equal(t, "John", name)
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		r := newRequest("gzip", compress("gzip", `{"name": "John"}`))
+		assert.ErrorContains(t, schema.ApplyHTTPRequest(nil, r, nil), "failed to unmarshal request body")
+	})
+
+	t.Run("decompressed size limit", func(t *testing.T) {
+		body := `{"name": "` + strings.Repeat("a", 10000) + `"}`
+		r := newRequest("gzip", compress("gzip", body))
+
+		err := schema.ApplyHTTPRequest(nil, r, &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingAuto, MaxRequestBodySize: 1 << 10, Decompress: true, MaxDecompressedBodySize: 1 << 10})
+		var maxBytesErr *http.MaxBytesError
+		assert.ErrorAs(t, err, &maxBytesErr)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		r := newRequest("gzip", strings.NewReader(`{"name": "John"}`))
+		assert.ErrorContains(t, schema.ApplyHTTPRequest(nil, r, &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingJSON, Decompress: true}), "failed to decompress request body")
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		r := newRequest("br", strings.NewReader(`{}`))
+		assert.EqualError(t, schema.ApplyHTTPRequest(nil, r, &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingJSON, Decompress: true}), `unsupported content encoding "br"`)
+	})
+}
+
+func TestWithRawBodyCapture(t *testing.T) {
+	var name string
+	schema := NewSchema(Value("name", &name))
+
+	t.Run("json", func(t *testing.T) {
+		body := `{"name": "John"}`
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+
+		var raw bytes.Buffer
+		require.NoError(t, schema.ApplyHTTPRequest(nil, r, nil, WithRawBodyCapture(&raw)))
+		assert.Equal(t, "John", name)
+		assert.Equal(t, body, raw.String())
+	})
+
+	t.Run("form", func(t *testing.T) {
+		body := "name=Jane"
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var raw bytes.Buffer
+		require.NoError(t, schema.ApplyHTTPRequest(nil, r, nil, WithRawBodyCapture(&raw)))
+		assert.Equal(t, "Jane", name)
+		assert.Equal(t, body, raw.String())
+	})
+
+	t.Run("captured before decompression", func(t *testing.T) {
+		var compressed bytes.Buffer
+		gz := gzip.NewWriter(&compressed)
+		_, err := gz.Write([]byte(`{"name": "John"}`))
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+		sent := compressed.String()
+
+		r := httptest.NewRequest(http.MethodPost, "/", &compressed)
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Content-Encoding", "gzip")
+
+		var raw bytes.Buffer
+		opt := &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingAuto, Decompress: true}
+		require.NoError(t, schema.ApplyHTTPRequest(nil, r, opt, WithRawBodyCapture(&raw)))
+		assert.Equal(t, sent, raw.String())
+	})
+
+	t.Run("options are applied once", func(t *testing.T) {
+		var raw bytes.Buffer
+		applied := 0
+		option := func(s *Schema) {
+			applied++
+			WithRawBodyCapture(&raw)(s)
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "John"}`))
+		r.Header.Set("Content-Type", "application/json")
+		require.NoError(t, schema.ApplyHTTPRequest(nil, r, nil, option))
+		assert.Equal(t, 1, applied)
+		assert.Equal(t, `{"name": "John"}`, raw.String())
+	})
+}
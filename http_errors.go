@@ -0,0 +1,152 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// problemDetails is an RFC 7807 problem document, rendered as JSON or XML
+type problemDetails struct {
+	XMLName xml.Name     `json:"-" xml:"urn:ietf:rfc:7807 problem"`
+	Type    string       `json:"type" xml:"type"`
+	Title   string       `json:"title" xml:"title"`
+	Status  int          `json:"status" xml:"status"`
+	Detail  string       `json:"detail,omitempty" xml:"detail,omitempty"`
+	Errors  []errorEntry `json:"errors,omitempty" xml:"errors>error,omitempty"`
+}
+
+// WriteErrors writes err as an HTTP error response, in the format requested by the Accept header of r:
+// an RFC 7807 problem document in JSON (application/problem+json, the default) or XML (application/problem+xml),
+// or one "field: message" line per error (text/plain). application/json and application/xml are answered with
+// the problem document too.
+//
+// Validation errors (Errors) are answered with 422 Unprocessable Entity and listed with their path
+// (e.g. "people[1].name", see Errors.Flatten), code and message, secondary errors being left out
+// (see FieldError.Secondary); other errors (e.g. a malformed body) with 400 Bad Request,
+// or 413 Request Entity Too Large when the body exceeds its size limit. It does nothing when err is nil.
+//
+//	if err := schema.ApplyHTTPRequest(w, r, nil); err != nil {
+//		poxxy.WriteErrors(w, r, err)
+//		return
+//	}
+func WriteErrors(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+
+	problem := newProblemDetails(err)
+	w.Header().Set("Vary", "Accept")
+
+	switch negotiateErrorType(r.Header.Get("Accept")) {
+	case "application/problem+xml":
+		body, marshalErr := xml.MarshalIndent(problem, "", "  ")
+		if marshalErr != nil {
+			http.Error(w, marshalErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/problem+xml")
+		w.WriteHeader(problem.Status)
+		_, _ = w.Write([]byte(xml.Header))
+		_, _ = w.Write(body)
+	case "text/plain":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(problem.Status)
+		if len(problem.Errors) == 0 {
+			_, _ = fmt.Fprintln(w, problem.Detail)
+			return
+		}
+		for _, fieldError := range problem.Errors {
+			_, _ = fmt.Fprintf(w, "%s: %s\n", fieldError.Field, fieldError.Message)
+		}
+	default:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(problem.Status)
+		_ = json.NewEncoder(w).Encode(problem)
+	}
+}
+
+// newProblemDetails builds the problem document of an error
+func newProblemDetails(err error) *problemDetails {
+	var fieldErrors Errors
+	if !errors.As(err, &fieldErrors) {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		return &problemDetails{
+			Type:   "about:blank",
+			Title:  http.StatusText(status),
+			Status: status,
+			Detail: err.Error(),
+		}
+	}
+
+	problem := &problemDetails{
+		Type:   "about:blank",
+		Title:  "Validation failed",
+		Status: http.StatusUnprocessableEntity,
+		Errors: fieldErrors.Flatten().Primary().entries(),
+	}
+
+	return problem
+}
+
+// negotiateErrorType returns the error media type best matching an Accept header: application/problem+json,
+// application/problem+xml or text/plain. Media ranges are tried by decreasing quality, in header order
+// for equal qualities. It defaults to application/problem+json.
+func negotiateErrorType(accept string) string {
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+		if mediaType == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.EqualFold(key, "q") {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+		}
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, r := range ranges {
+		switch r.mediaType {
+		case "application/problem+json", "application/json", "*/*", "application/*":
+			return "application/problem+json"
+		case "application/problem+xml", "application/xml", "text/xml":
+			return "application/problem+xml"
+		case "text/plain", "text/*":
+			return "text/plain"
+		}
+	}
+
+	return "application/problem+json"
+}
@@ -0,0 +1,89 @@
+package poxxy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteErrors(t *testing.T) {
+	var name string
+	var age int
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required())),
+		Value("age", &age, WithValidators(Min(18))),
+	)
+	err := schema.Apply(map[string]interface{}{"age": 12})
+	require.Error(t, err)
+
+	write := func(accept string, err error) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		if accept != "" {
+			r.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		WriteErrors(w, r, err)
+		return w
+	}
+
+	t.Run("problem+json by default", func(t *testing.T) {
+		w := write("", err)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "about:blank",
+			"title": "Validation failed",
+			"status": 422,
+			"errors": [
+				{"field": "name", "path": ["name"], "code": "required", "message": "field is required"},
+				{"field": "age", "path": ["age"], "code": "min", "message": "value must be at least 18"}
+			]
+		}`, w.Body.String())
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		w := write("application/xml", err)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "application/problem+xml", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `<problem xmlns="urn:ietf:rfc:7807">`)
+		assert.Contains(t, w.Body.String(), `<error>
+      <field>name</field>
+      <code>required</code>
+      <message>field is required</message>
+    </error>`)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		w := write("text/html, text/plain;q=0.9", err)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "name: field is required\nage: value must be at least 18\n", w.Body.String())
+	})
+
+	t.Run("quality", func(t *testing.T) {
+		assert.Equal(t, "text/plain", negotiateErrorType("application/json;q=0.5, text/plain"))
+		assert.Equal(t, "application/problem+xml", negotiateErrorType("text/plain;q=0, application/*;q=0.1, text/xml;q=0.2"))
+		assert.Equal(t, "application/problem+json", negotiateErrorType("image/png"))
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		w := write("text/plain", errors.New("failed to unmarshal request body: unexpected EOF"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "failed to unmarshal request body: unexpected EOF\n", w.Body.String())
+
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "`+strings.Repeat("a", 100)+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+		err := schema.ApplyHTTPRequest(httptest.NewRecorder(), r, &HTTPRequestOption{ContentTypeParsing: ContentTypeParsingJSON, MaxRequestBodySize: 10})
+		assert.Equal(t, http.StatusRequestEntityTooLarge, write("", err).Code)
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		w := write("", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
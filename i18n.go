@@ -0,0 +1,145 @@
+package poxxy
+
+import (
+	"strings"
+	"sync"
+)
+
+// Translator formats the messages of the built-in validators in a given language.
+// params holds the parameters of the failure (e.g. "min" for CodeMin, "max" for CodeMaxLength).
+// It returns false when it has no message for the code, in which case the default message is used.
+type Translator interface {
+	Translate(code string, params map[string]string) (string, bool)
+}
+
+// Catalog is a Translator holding the messages of a language indexed by code.
+// Messages may use the same placeholders as the built-in ones (e.g. "doit être au moins {min}").
+type Catalog map[string]string
+
+// Translate returns the message of the code with its placeholders replaced
+func (c Catalog) Translate(code string, params map[string]string) (string, bool) {
+	msg, ok := c[code]
+	if !ok {
+		return "", false
+	}
+
+	if len(params) == 0 {
+		return msg, true
+	}
+
+	pairs := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+
+	return strings.NewReplacer(pairs...).Replace(msg), true
+}
+
+var (
+	translatorsMu sync.RWMutex
+	translators   = map[string]Translator{}
+)
+
+// RegisterTranslator registers the translator of a locale (e.g. "fr", "de", "de-CH") for WithLocale.
+// Passing a nil translator removes the locale.
+//
+// It is meant to be called once at application startup.
+func RegisterTranslator(locale string, translator Translator) {
+	translatorsMu.Lock()
+	defer translatorsMu.Unlock()
+
+	if translator == nil {
+		delete(translators, normalizeLocale(locale))
+		return
+	}
+
+	translators[normalizeLocale(locale)] = translator
+}
+
+// WithLocale creates a schema option translating the messages of the validation errors
+// with the translator registered for the locale. A regional locale (e.g. "fr-CA") falls back
+// to its language ("fr"), and unknown locales keep the default messages.
+func WithLocale(locale string) SchemaOption {
+	return func(s *Schema) {
+		s.translator = lookupTranslator(locale)
+	}
+}
+
+// WithTranslator creates a schema option translating the messages of the validation errors with translator
+func WithTranslator(translator Translator) SchemaOption {
+	return func(s *Schema) {
+		s.translator = translator
+	}
+}
+
+// lookupTranslator returns the translator registered for the locale or its language, or nil
+func lookupTranslator(locale string) Translator {
+	locale = normalizeLocale(locale)
+
+	translatorsMu.RLock()
+	defer translatorsMu.RUnlock()
+
+	if translator, ok := translators[locale]; ok {
+		return translator
+	}
+
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		return translators[locale[:i]]
+	}
+
+	return nil
+}
+
+// normalizeLocale lowercases a locale and uses "-" as separator ("en_US" becomes "en-us")
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+// translateErrors replaces the messages of the validation errors by their translation.
+// Custom messages set with WithMessage are kept as is.
+func (s *Schema) translateErrors(errors Errors) {
+	if s.translator != nil {
+		errors.translate(s.translator)
+	}
+}
+
+// translateError returns err with the messages of the validation errors it contains translated
+func translateError(err error, translator Translator) error {
+	switch e := err.(type) {
+	case *ValidationError:
+		if e.Message != "" {
+			return e
+		}
+		msg, ok := translator.Translate(e.Code, e.Params())
+		if !ok {
+			return e
+		}
+		return &ValidationError{Code: e.Code, Message: msg, params: e.params}
+	case Errors:
+		translated := make(Errors, len(e))
+		copy(translated, e)
+		translated.translate(translator)
+		return translated
+	case ElementErrors:
+		translated := make(ElementErrors, len(e))
+		for i, elementError := range e {
+			translated[i] = ElementError{Index: elementError.Index, Error: translateError(elementError.Error, translator)}
+		}
+		return translated
+	case KeyErrors:
+		translated := make(KeyErrors, len(e))
+		for i, keyError := range e {
+			translated[i] = KeyError{Key: keyError.Key, Error: translateError(keyError.Error, translator)}
+		}
+		return translated
+	}
+
+	return err
+}
+
+// translate translates the errors in place
+func (e Errors) translate(translator Translator) {
+	for i := range e {
+		e[i].Error = translateError(e[i].Error, translator)
+	}
+}
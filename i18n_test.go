@@ -0,0 +1,64 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLocale(t *testing.T) {
+	RegisterTranslator("fr", Catalog{
+		CodeRequired:  "ce champ est obligatoire",
+		CodeMin:       "doit être au moins {min}",
+		CodeMaxLength: "doit contenir au plus {max} caractères",
+	})
+	RegisterTranslator("de", Catalog{
+		CodeRequired: "Dieses Feld ist erforderlich",
+	})
+	defer RegisterTranslator("fr", nil)
+	defer RegisterTranslator("de", nil)
+
+	var name, nickname string
+	var age int
+	var tags []string
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required())),
+		Value("nickname", &nickname, WithValidators(Required().WithMessage("pick a nickname"))),
+		Value("age", &age, WithValidators(Min(18))),
+		Slice("tags", &tags, WithValidators(EachAll(MaxLength(3)))),
+	)
+	data := map[string]interface{}{"age": 12, "tags": []interface{}{"go", "golang"}}
+
+	err := schema.Apply(data, WithLocale("fr-FR"))
+	require.Error(t, err)
+	assert.EqualError(t, err, "name: ce champ est obligatoire; nickname: pick a nickname; age: doit être au moins 18; tags: element 1: doit contenir au plus 3 caractères")
+	assert.Equal(t, CodeMin, err.(Errors)[2].Code)
+
+	err = schema.Apply(data, WithLocale("de_CH"))
+	require.Error(t, err)
+	assert.EqualError(t, err, "name: Dieses Feld ist erforderlich; nickname: pick a nickname; age: value must be at least 18; tags: element 1: must be at most 3 characters long")
+
+	t.Run("unknown locale keeps the default messages", func(t *testing.T) {
+		err := schema.Apply(data, WithLocale("es"))
+		assert.EqualError(t, err, "name: field is required; nickname: pick a nickname; age: value must be at least 18; tags: element 1: must be at most 3 characters long")
+	})
+
+	t.Run("locale applies to a single Apply", func(t *testing.T) {
+		require.Error(t, schema.Apply(data, WithLocale("fr")))
+		assert.Contains(t, schema.Apply(data).Error(), "name: field is required")
+	})
+
+	t.Run("custom translator", func(t *testing.T) {
+		err := schema.Apply(data, WithTranslator(Catalog{CodeRequired: "requis"}))
+		assert.Contains(t, err.Error(), "name: requis")
+	})
+}
+
+func TestValidationError_Params(t *testing.T) {
+	var validationErr *ValidationError
+	require.ErrorAs(t, Min(18).Validate(12, "age"), &validationErr)
+	assert.Equal(t, map[string]string{"min": "18"}, validationErr.Params())
+
+	assert.Nil(t, errRequired.Params())
+}
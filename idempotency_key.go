@@ -0,0 +1,40 @@
+package poxxy
+
+// IdempotencyKeyHeader is the HTTP header read by IdempotencyKey
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength is the length of a UUID, the longest accepted key
+const maxIdempotencyKeyLength = 36
+
+// IdempotencyKey declares the "idempotency_key" field of the endpoints accepting idempotent retries.
+// The key is read from the Idempotency-Key header when the schema is applied to an HTTP request, or from
+// the "idempotency_key" key of the input; it must be a UUID or a ULID. opts are applied to the field,
+// e.g. WithValidators(Required()) to require a key.
+//
+//	var key string
+//	schema := poxxy.NewSchema(
+//		poxxy.IdempotencyKey(&key, poxxy.WithValidators(poxxy.Required())),
+//		poxxy.Value("amount", &amount),
+//	)
+func IdempotencyKey(key *string, opts ...Option) Field {
+	opts = append([]Option{WithValidators(idempotencyKeyValidator())}, opts...)
+
+	return &compositeField{
+		name: "idempotency_key",
+		fields: []Field{
+			Value("idempotency_key", key, opts...),
+			FromHeader(IdempotencyKeyHeader, "idempotency_key"),
+		},
+	}
+}
+
+// idempotencyKeyValidator validates that a string is a UUID or a ULID
+func idempotencyKeyValidator() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "idempotency_key"}, func(str string) error {
+		// Oversized keys are rejected without being matched
+		if len(str) > maxIdempotencyKeyLength || (!uuidRegex.MatchString(str) && !ulidRegex.MatchString(str)) {
+			return newValidationError(CodeIdempotencyKey)
+		}
+		return nil
+	})
+}
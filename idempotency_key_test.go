@@ -0,0 +1,52 @@
+package poxxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	apply := func(header, body string) (string, error) {
+		var key string
+		var amount int
+		schema := NewSchema(
+			IdempotencyKey(&key, WithValidators(Required())),
+			Value("amount", &amount),
+		)
+
+		r := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		if header != "" {
+			r.Header.Set(IdempotencyKeyHeader, header)
+		}
+
+		err := schema.ApplyHTTPRequest(httptest.NewRecorder(), r, nil)
+		return key, err
+	}
+
+	key, err := apply("f47ac10b-58cc-4372-a567-0e02b2c3d479", `{"amount": 10}`)
+	require.NoError(t, err)
+	assert.Equal(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", key)
+
+	key, err = apply("", `{"amount": 10, "idempotency_key": "01ARZ3NDEKTSV4RRFFQ69G5FAV"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", key)
+
+	key, err = apply("01ARZ3NDEKTSV4RRFFQ69G5FAV", `{"amount": 10, "idempotency_key": "f47ac10b-58cc-4372-a567-0e02b2c3d479"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", key, "the header wins")
+
+	_, err = apply("retry-1", `{"amount": 10}`)
+	assert.EqualError(t, err, "idempotency_key: must be a UUID or a ULID")
+
+	_, err = apply(strings.Repeat("0", 4096), `{"amount": 10}`)
+	assert.EqualError(t, err, "idempotency_key: must be a UUID or a ULID")
+
+	_, err = apply("", `{"amount": 10}`)
+	assert.EqualError(t, err, "idempotency_key: field is required")
+}
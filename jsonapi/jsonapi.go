@@ -0,0 +1,242 @@
+// Package jsonapi binds JSON:API resource documents (https://jsonapi.org) onto poxxy schemas.
+//
+// The schema is written for the resource itself: its fields are the attributes of the resource, "id" for
+// its id, and the relationships, which are bound to the ids of the resources they link to (a list of ids
+// for to-many relationships). The document type and id are validated, and error paths point into the
+// document (e.g. "data.attributes.title" or "data.relationships.author.data").
+package jsonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/arkan/poxxy"
+)
+
+// Resource describes the resource a document must hold
+type Resource struct {
+	// Type is the expected "type" of the resource
+	Type string
+	// ID is the expected "id" of the resource, e.g. the id of the URL of an update. When empty, the id is
+	// optional, as clients may let the server generate it.
+	ID string
+	// Relationships maps relationship names to the type of the resources they must link to.
+	// Relationships not listed are bound without checking their type.
+	Relationships map[string]string
+}
+
+// Apply validates the resource document against resource and applies the resource to the schema.
+//
+//	var article Article
+//	schema := poxxy.NewSchema(
+//		poxxy.Value("title", &article.Title, poxxy.WithValidators(poxxy.Required())),
+//		poxxy.Value("author", &article.AuthorID, poxxy.WithValidators(poxxy.Required())),
+//	)
+//	err := jsonapi.Apply(schema, document, jsonapi.Resource{
+//		Type:          "articles",
+//		Relationships: map[string]string{"author": "people"},
+//	})
+func Apply(schema *poxxy.Schema, document map[string]interface{}, resource Resource, options ...poxxy.SchemaOption) error {
+	data, ok := document["data"].(map[string]interface{})
+	if !ok {
+		return documentError(document["data"], poxxy.Path{"data"})
+	}
+
+	input, errs := bindResource(data, resource)
+
+	err := schema.Apply(input, options...)
+	if err == nil {
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	}
+
+	var schemaErrs poxxy.Errors
+	if !errors.As(err, &schemaErrs) {
+		return err
+	}
+
+	relationships, _ := data["relationships"].(map[string]interface{})
+	for _, fieldError := range schemaErrs {
+		fieldError.Path = resourcePath(fieldError.Path, relationships)
+		errs = append(errs, fieldError)
+	}
+
+	return errs
+}
+
+// ApplyJSON parses a JSON:API resource document and applies it like Apply
+func ApplyJSON(schema *poxxy.Schema, jsonData []byte, resource Resource, options ...poxxy.SchemaOption) error {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal(jsonData, &document); err != nil {
+		return fmt.Errorf("failed to unmarshal request body: %w", err)
+	}
+
+	return Apply(schema, document, resource, options...)
+}
+
+// bindResource returns the input of the schema for the resource object data, and the errors of the
+// resource type, id and relationship linkages
+func bindResource(data map[string]interface{}, resource Resource) (map[string]interface{}, poxxy.Errors) {
+	var errs poxxy.Errors
+
+	if err := checkValue(data["type"], resource.Type, resource.Type != ""); err != nil {
+		errs = append(errs, errorAt(poxxy.Path{"data", "type"}, err))
+	}
+
+	input := make(map[string]interface{})
+
+	if id, exists := data["id"]; exists {
+		input["id"] = id
+	}
+	if resource.ID != "" {
+		if err := checkValue(data["id"], resource.ID, true); err != nil {
+			errs = append(errs, errorAt(poxxy.Path{"data", "id"}, err))
+		}
+	}
+
+	// The fields of a resource share a namespace: "id" and "type" are reserved, and an attribute can't be
+	// named after a relationship, so the id checked against Resource.ID can't be replaced
+	attributes, ok := data["attributes"].(map[string]interface{})
+	if !ok && data["attributes"] != nil {
+		errs = append(errs, errorAt(poxxy.Path{"data", "attributes"}, fmt.Errorf("expected object")))
+	}
+	relationships, _ := data["relationships"].(map[string]interface{})
+	for _, name := range sortedKeys(attributes) {
+		if _, exists := relationships[name]; exists || isReservedMember(name) {
+			errs = append(errs, errorAt(poxxy.Path{"data", "attributes", name}, fmt.Errorf("%q is not allowed as an attribute name", name)))
+			continue
+		}
+		input[name] = attributes[name]
+	}
+
+	if relationships == nil && data["relationships"] != nil {
+		errs = append(errs, errorAt(poxxy.Path{"data", "relationships"}, fmt.Errorf("expected object")))
+	}
+	for _, name := range sortedKeys(relationships) {
+		if isReservedMember(name) {
+			errs = append(errs, errorAt(poxxy.Path{"data", "relationships", name}, fmt.Errorf("%q is not allowed as a relationship name", name)))
+			continue
+		}
+
+		path := poxxy.Path{"data", "relationships", name, "data"}
+		ids, linkageErrs := bindRelationship(relationships[name], resource.Relationships[name], path)
+		errs = append(errs, linkageErrs...)
+		input[name] = ids
+	}
+
+	return input, errs
+}
+
+// isReservedMember reports whether name is reserved by JSON:API for the members of resource objects
+func isReservedMember(name string) bool {
+	return name == "id" || name == "type"
+}
+
+// sortedKeys returns the keys of an object, sorted for the errors to be reported in a stable order
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// bindRelationship returns the id, or the list of ids, of the resources linked by a relationship object,
+// and the errors of its resource linkage, located at path
+func bindRelationship(relationship interface{}, resourceType string, path poxxy.Path) (interface{}, poxxy.Errors) {
+	object, ok := relationship.(map[string]interface{})
+	if !ok {
+		return nil, poxxy.Errors{errorAt(path[:len(path)-1], fmt.Errorf("expected relationship object"))}
+	}
+
+	switch linkage := object["data"].(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		var errs poxxy.Errors
+		ids := make([]interface{}, len(linkage))
+		for i, element := range linkage {
+			id, linkageErrs := linkageID(element, resourceType, append(path[:len(path):len(path)], i))
+			errs = append(errs, linkageErrs...)
+			ids[i] = id
+		}
+		return ids, errs
+	default:
+		return linkageID(linkage, resourceType, path)
+	}
+}
+
+// linkageID returns the id of a resource identifier object, checking its type when resourceType is set
+func linkageID(identifier interface{}, resourceType string, path poxxy.Path) (interface{}, poxxy.Errors) {
+	object, ok := identifier.(map[string]interface{})
+	if !ok {
+		return nil, poxxy.Errors{errorAt(path, fmt.Errorf("expected resource identifier object"))}
+	}
+
+	if err := checkValue(object["type"], resourceType, resourceType != ""); err != nil {
+		return nil, poxxy.Errors{errorAt(append(path[:len(path):len(path)], "type"), err)}
+	}
+
+	return object["id"], nil
+}
+
+// checkValue checks that value equals expected, when check is set, or is at least provided when required
+func checkValue(value interface{}, expected string, check bool) error {
+	if value == nil || value == "" {
+		return &poxxy.ValidationError{Code: poxxy.CodeRequired}
+	}
+	if !check {
+		return nil
+	}
+
+	return poxxy.In(expected).Validate(value, "")
+}
+
+// resourcePath returns the path in the document of an error of the schema: "id" is the id of the
+// resource, relationship names their resource linkage and other fields are attributes
+func resourcePath(path poxxy.Path, relationships map[string]interface{}) poxxy.Path {
+	if len(path) == 0 {
+		return poxxy.Path{"data"}
+	}
+
+	var prefix poxxy.Path
+	name, _ := path[0].(string)
+	if _, exists := relationships[name]; exists {
+		prefix = poxxy.Path{"data", "relationships", name, "data"}
+		path = path[1:]
+	} else if name == "id" {
+		prefix = poxxy.Path{"data"}
+	} else {
+		prefix = poxxy.Path{"data", "attributes"}
+	}
+
+	return append(prefix, path...)
+}
+
+// documentError returns the error of a document whose primary data isn't a resource object
+func documentError(data interface{}, path poxxy.Path) poxxy.Errors {
+	if data == nil {
+		return poxxy.Errors{errorAt(path, &poxxy.ValidationError{Code: poxxy.CodeRequired})}
+	}
+
+	return poxxy.Errors{errorAt(path, fmt.Errorf("expected resource object, got %T", data))}
+}
+
+// errorAt returns the error of the document value located at path
+func errorAt(path poxxy.Path, err error) poxxy.FieldError {
+	result := poxxy.FieldError{Field: path.String(), Error: err, Path: path}
+
+	var validationErr *poxxy.ValidationError
+	if errors.As(err, &validationErr) {
+		result.Code = validationErr.Code
+	}
+
+	return result
+}
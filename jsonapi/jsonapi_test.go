@@ -0,0 +1,133 @@
+package jsonapi
+
+import (
+	"testing"
+
+	"github.com/arkan/poxxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type article struct {
+	ID       string
+	Title    string
+	AuthorID string
+	TagIDs   []string
+}
+
+func newArticleSchema(a *article) *poxxy.Schema {
+	return poxxy.NewSchema(
+		poxxy.Value("id", &a.ID),
+		poxxy.Value("title", &a.Title, poxxy.WithValidators(poxxy.Required())),
+		poxxy.Value("author", &a.AuthorID, poxxy.WithValidators(poxxy.Required())),
+		poxxy.Slice("tags", &a.TagIDs, poxxy.WithValidators(poxxy.Each(poxxy.MinLength(2)))),
+	)
+}
+
+var articleResource = Resource{
+	Type:          "articles",
+	Relationships: map[string]string{"author": "people", "tags": "tags"},
+}
+
+func TestApplyJSON(t *testing.T) {
+	var a article
+	err := ApplyJSON(newArticleSchema(&a), []byte(`{
+		"data": {
+			"type": "articles",
+			"id": "1",
+			"attributes": {"title": "JSON:API paints my bikeshed!"},
+			"relationships": {
+				"author": {"data": {"type": "people", "id": "9"}},
+				"tags": {"data": [{"type": "tags", "id": "go"}, {"type": "tags", "id": "api"}]}
+			}
+		},
+		"meta": {"request_id": "abc"}
+	}`), articleResource)
+	require.NoError(t, err)
+	assert.Equal(t, article{ID: "1", Title: "JSON:API paints my bikeshed!", AuthorID: "9", TagIDs: []string{"go", "api"}}, a)
+
+	err = ApplyJSON(newArticleSchema(&a), []byte(`{"data": `), articleResource)
+	assert.ErrorContains(t, err, "failed to unmarshal request body")
+}
+
+func TestApply(t *testing.T) {
+	paths := func(t *testing.T, err error) []string {
+		var errs poxxy.Errors
+		require.ErrorAs(t, err, &errs)
+
+		var paths []string
+		for _, fieldError := range errs.Flatten() {
+			paths = append(paths, fieldError.Path.String())
+		}
+		return paths
+	}
+
+	t.Run("schema errors point into the document", func(t *testing.T) {
+		var a article
+		err := Apply(newArticleSchema(&a), map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":       "articles",
+				"attributes": map[string]interface{}{},
+				"relationships": map[string]interface{}{
+					"author": map[string]interface{}{"data": nil},
+					"tags": map[string]interface{}{"data": []interface{}{
+						map[string]interface{}{"type": "tags", "id": "go"},
+						map[string]interface{}{"type": "tags", "id": "x"},
+					}},
+				},
+			},
+		}, articleResource)
+		assert.Equal(t, []string{"data.attributes.title", "data.relationships.author.data", "data.relationships.tags.data"}, paths(t, err))
+	})
+
+	t.Run("resource type and id", func(t *testing.T) {
+		var a article
+		resource := articleResource
+		resource.ID = "1"
+		err := Apply(newArticleSchema(&a), map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":       "people",
+				"id":         "2",
+				"attributes": map[string]interface{}{"title": "Hello"},
+				"relationships": map[string]interface{}{
+					"author": map[string]interface{}{"data": map[string]interface{}{"type": "articles", "id": "9"}},
+				},
+			},
+		}, resource)
+		assert.Equal(t, []string{"data.type", "data.id", "data.relationships.author.data.type", "data.relationships.author.data"}, paths(t, err))
+
+		var errs poxxy.Errors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, poxxy.CodeIn, errs[0].Code)
+		assert.Equal(t, poxxy.CodeRequired, errs[3].Code)
+	})
+
+	t.Run("reserved and duplicated field names", func(t *testing.T) {
+		a := article{ID: "unchanged"}
+		resource := articleResource
+		resource.ID = "5"
+		err := Apply(newArticleSchema(&a), map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":       "articles",
+				"id":         "5",
+				"attributes": map[string]interface{}{"id": "6", "title": "Hello", "author": "1"},
+				"relationships": map[string]interface{}{
+					"author": map[string]interface{}{"data": map[string]interface{}{"type": "people", "id": "9"}},
+					"type":   map[string]interface{}{"data": nil},
+				},
+			},
+		}, resource)
+		assert.Equal(t, []string{"data.attributes.author", "data.attributes.id", "data.relationships.type"}, paths(t, err))
+		assert.Equal(t, "5", a.ID)
+		assert.Equal(t, "9", a.AuthorID)
+	})
+
+	t.Run("missing primary data", func(t *testing.T) {
+		var a article
+		err := Apply(newArticleSchema(&a), map[string]interface{}{"meta": map[string]interface{}{}}, articleResource)
+		assert.EqualError(t, err, "data: field is required")
+
+		err = Apply(newArticleSchema(&a), map[string]interface{}{"data": []interface{}{}}, articleResource)
+		assert.EqualError(t, err, "data: expected resource object, got []interface {}")
+	})
+}
@@ -0,0 +1,257 @@
+package poxxy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Codes identifying the messages of the built-in validators
+const (
+	CodeRequired         = "required"
+	CodeNotEmpty         = "not_empty"
+	CodeNotBlank         = "not_blank"
+	CodeEmail            = "email"
+	CodeMin              = "min"
+	CodeMax              = "max"
+	CodeMinLength        = "min_length"
+	CodeMaxLength        = "max_length"
+	CodeMinItems         = "min_items"
+	CodeMaxItems         = "max_items"
+	CodeURL              = "url"
+	CodeURLScheme        = "url_scheme"
+	CodePrivateHost      = "private_host"
+	CodeUnresolvableHost = "unresolvable_host"
+	CodeURLHostIn        = "url_host_in"
+	CodeIn               = "in"
+	CodeUnique           = "unique"
+	CodeUniqueBy         = "unique_by"
+	CodeMapKeys          = "map_keys"
+	CodeTimeZone         = "timezone"
+	CodeDateOrder        = "date_order"
+	CodeDateSpan         = "date_span"
+	CodeCurrency         = "currency"
+	CodeMoneyScale       = "money_scale"
+	CodePercent          = "percent"
+	CodeRatio            = "ratio"
+	CodeFinite           = "finite"
+	CodeExists           = "exists"
+	CodeNotExists        = "not_exists"
+	CodeTimeout          = "timeout"
+	CodeThrottled        = "throttled"
+	CodeRequiredTogether = "required_together"
+	CodeAtMostOneOf      = "at_most_one_of"
+	CodeEqualToField     = "equal_to_field"
+	CodeChecksumMismatch = "checksum_mismatch"
+	CodeIdempotencyKey   = "idempotency_key"
+	CodeUnknownField     = "unknown_field"
+	CodeKind             = "kind"
+	CodeMaxDepth         = "max_depth"
+	CodeMaxSize          = "max_size"
+	CodeMaxFileSize      = "max_file_size"
+	CodeMIMEType         = "mime_type"
+	CodeImage            = "image"
+	CodeImageDimensions  = "image_dimensions"
+	CodeImageFormat      = "image_format"
+	CodeArchive          = "archive"
+	CodeArchiveEntries   = "archive_entries"
+	CodeArchiveSize      = "archive_size"
+	CodeArchivePath      = "archive_path"
+	CodeHexColor         = "hex_color"
+	CodeRGBColor         = "rgb_color"
+	CodeCron             = "cron"
+	CodePort             = "port"
+	CodeHostPort         = "host_port"
+	CodeUUID             = "uuid"
+	CodeULID             = "ulid"
+	CodeKSUID            = "ksuid"
+	CodeEAN13            = "ean13"
+	CodeISBN             = "isbn"
+	CodeSIREN            = "siren"
+	CodeSIRET            = "siret"
+	CodeVATNumber        = "vat_number"
+	CodeVATNotRegistered = "vat_not_registered"
+	CodePostalCode       = "postal_code"
+	CodeLanguageTag      = "language_tag"
+	CodePrintable        = "printable"
+	CodeNoEmoji          = "no_emoji"
+	CodeMaxEmoji         = "max_emoji"
+	CodeNotMatchingAny   = "not_matching_any"
+	CodeDenylisted       = "denylisted"
+)
+
+// builtinMessages holds the built-in English messages indexed by code.
+// Placeholders like {min} are replaced by the validator parameters.
+var builtinMessages = map[string]string{
+	CodeRequired:         "field is required",
+	CodeNotEmpty:         "value cannot be empty",
+	CodeNotBlank:         "value cannot be blank",
+	CodeEmail:            "invalid email format",
+	CodeMin:              "value must be at least {min}",
+	CodeMax:              "value must be at most {max}",
+	CodeMinLength:        "must be at least {min} characters long",
+	CodeMaxLength:        "must be at most {max} characters long",
+	CodeMinItems:         "must have at least {min} items",
+	CodeMaxItems:         "must have at most {max} items",
+	CodeURL:              "invalid URL format",
+	CodeURLScheme:        "URL scheme must be one of {schemes}",
+	CodePrivateHost:      "URL must not point to a private host",
+	CodeUnresolvableHost: "URL host cannot be resolved",
+	CodeURLHostIn:        "URL host is not allowed",
+	CodeIn:               "value {value} must be one of: {values}",
+	CodeUnique:           "duplicate value found: {value}",
+	CodeUniqueBy:         "duplicate key found: {key}",
+	CodeMapKeys:          "key {key} not found in map",
+	CodeTimeZone:         "invalid time zone",
+	CodeDateOrder:        "must not be before {start}",
+	CodeDateSpan:         "range must not exceed {max}",
+	CodeCurrency:         "invalid currency code {currency}",
+	CodeMoneyScale:       "amount must have at most {digits} decimal places",
+	CodePercent:          "must be a percentage between 0 and 100",
+	CodeRatio:            "must be a ratio between 0 and 1",
+	CodeFinite:           "must be a finite number",
+	CodeExists:           "value {value} does not exist",
+	CodeNotExists:        "value {value} already exists",
+	CodeTimeout:          "validation timed out",
+	CodeThrottled:        "validation temporarily unavailable, please retry later",
+	CodeRequiredTogether: "required together with {fields}",
+	CodeAtMostOneOf:      "conflicts with {fields}",
+	CodeEqualToField:     "must match {field}",
+	CodeChecksumMismatch: "does not match the checksum of {field}",
+	CodeIdempotencyKey:   "must be a UUID or a ULID",
+	CodeUnknownField:     "unknown field",
+	CodeKind:             "must be one of: {kinds} (got {kind})",
+	CodeMaxDepth:         "must not be nested deeper than {max} levels",
+	CodeMaxSize:          "must be at most {max} bytes once encoded",
+	CodeMaxFileSize:      "file must be at most {max} bytes",
+	CodeMIMEType:         "file type {type} is not allowed",
+	CodeImage:            "file is not a valid image",
+	CodeImageDimensions:  "image must be at most {width}x{height} pixels",
+	CodeImageFormat:      "image format must be one of {formats}",
+	CodeArchive:          "file is not a valid zip or tar archive",
+	CodeArchiveEntries:   "archive must contain at most {max} entries",
+	CodeArchiveSize:      "archive must be at most {max} bytes uncompressed",
+	CodeArchivePath:      "archive entry {path} is outside the archive",
+	CodeHexColor:         "must be a hexadecimal color like #ff0000",
+	CodeRGBColor:         "must be an RGB color like rgb(255, 0, 0)",
+	CodeCron:             "invalid cron expression: {reason}",
+	CodePort:             "must be a port between {min} and 65535",
+	CodeHostPort:         "must be a host:port address",
+	CodeUUID:             "invalid UUID",
+	CodeULID:             "invalid ULID",
+	CodeKSUID:            "invalid KSUID",
+	CodeEAN13:            "invalid EAN-13 number",
+	CodeISBN:             "invalid ISBN",
+	CodeSIREN:            "invalid SIREN number",
+	CodeSIRET:            "invalid SIRET number",
+	CodeVATNumber:        "invalid VAT number",
+	CodeVATNotRegistered: "VAT number {value} is not registered",
+	CodePostalCode:       "invalid postal code for {country}",
+	CodeLanguageTag:      "invalid language tag",
+	CodePrintable:        "must only contain printable characters",
+	CodeNoEmoji:          "must not contain emoji",
+	CodeMaxEmoji:         "must contain at most {max} emoji",
+	CodeNotMatchingAny:   "value is not allowed",
+	CodeDenylisted:       "contains a forbidden word",
+}
+
+var (
+	messagesMu     sync.RWMutex
+	customMessages = map[string]string{}
+)
+
+// SetDefaultMessages overrides the default messages of the built-in validators, indexed by code.
+// Codes missing from the map keep their current message. Passing nil restores the built-in English messages.
+// Messages may use the same placeholders as the built-in ones (e.g. "doit être au moins {min}").
+//
+// It is meant to be called once at application startup.
+func SetDefaultMessages(messages map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+
+	if messages == nil {
+		customMessages = map[string]string{}
+		return
+	}
+
+	for code, msg := range messages {
+		customMessages[code] = msg
+	}
+}
+
+// ValidationError represents an error returned by a built-in validator
+type ValidationError struct {
+	// Code is a stable machine-readable identifier of the failure (e.g. "required", "min")
+	Code string
+	// Message overrides the message of the code when set.
+	// Built-in validators leave it empty: their message is rendered by Error, only when needed.
+	Message string
+
+	params []string
+}
+
+// errRequired is shared by every required failure to avoid an allocation per missing field
+var errRequired = &ValidationError{Code: CodeRequired}
+
+// Error returns the message of the validation error
+func (e *ValidationError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	return formatMessage(e.Code, e.params...)
+}
+
+// Params returns the parameters of the failure indexed by placeholder name (e.g. "min": "18"), or nil
+func (e *ValidationError) Params() map[string]string {
+	if len(e.params) == 0 {
+		return nil
+	}
+
+	params := make(map[string]string, len(e.params)/2)
+	for i := 0; i+1 < len(e.params); i += 2 {
+		params[e.params[i]] = e.params[i+1]
+	}
+
+	return params
+}
+
+// newValidationError creates a validation error for the given code.
+// params is a list of placeholder/value pairs (e.g. "min", "18"); the message is rendered lazily,
+// so it reflects the messages set with SetDefaultMessages when the error is displayed.
+func newValidationError(code string, params ...string) error {
+	return &ValidationError{Code: code, params: params}
+}
+
+// withMessage returns an error with the custom message msg replacing the message of err.
+// The code of err is kept when it is a ValidationError, so clients can still rely on it.
+func withMessage(err error, msg string) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return &ValidationError{Code: validationErr.Code, Message: msg}
+	}
+
+	return fmt.Errorf("%s", msg)
+}
+
+// formatMessage returns the message of the given code with its placeholders replaced
+func formatMessage(code string, params ...string) string {
+	messagesMu.RLock()
+	msg, ok := customMessages[code]
+	messagesMu.RUnlock()
+	if !ok {
+		msg = builtinMessages[code]
+	}
+
+	if len(params) == 0 {
+		return msg
+	}
+
+	pairs := make([]string, 0, len(params))
+	for i := 0; i+1 < len(params); i += 2 {
+		pairs = append(pairs, "{"+params[i]+"}", params[i+1])
+	}
+
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
@@ -0,0 +1,94 @@
+package poxxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDefaultMessages(t *testing.T) {
+	t.Cleanup(func() { SetDefaultMessages(nil) })
+
+	SetDefaultMessages(map[string]string{
+		CodeRequired:  "champ obligatoire",
+		CodeMin:       "doit être au moins {min}",
+		CodeMinLength: "doit contenir au moins {min} caractères",
+	})
+
+	var name string
+	var age int
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required(), MinLength(3))),
+		Value("age", &age, WithValidators(Min(18))),
+		Value("email", new(string), WithValidators(Required())),
+	)
+
+	err := schema.Apply(map[string]interface{}{"name": "Al", "age": 12})
+	assert.Error(t, err)
+	assert.Equal(t, "name: doit contenir au moins 3 caractères; age: doit être au moins 18; email: champ obligatoire", err.Error())
+
+	t.Run("codes missing from the map keep their message", func(t *testing.T) {
+		err := Email().Validate("invalid", "email")
+		assert.Equal(t, "invalid email format", err.Error())
+	})
+
+	t.Run("WithMessage takes precedence", func(t *testing.T) {
+		err := Min(18).WithMessage("trop jeune").Validate(12, "age")
+		assert.Equal(t, "trop jeune", err.Error())
+	})
+
+	t.Run("nil restores the built-in messages", func(t *testing.T) {
+		SetDefaultMessages(nil)
+		err := Min(18).Validate(12, "age")
+		assert.Equal(t, "value must be at least 18", err.Error())
+	})
+}
+
+func TestValidationError_Code(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		code      string
+	}{
+		{"not empty", NotEmpty(), "", CodeNotEmpty},
+		{"not empty nil", NotEmpty(), nil, CodeNotEmpty},
+		{"not empty nil pointer", NotEmpty(), (*int)(nil), CodeNotEmpty},
+		{"email", Email(), "invalid", CodeEmail},
+		{"min", Min(10), 5, CodeMin},
+		{"max", Max(10), 15, CodeMax},
+		{"min length", MinLength(3), "ab", CodeMinLength},
+		{"min items", MinLength(3), []string{"a"}, CodeMinItems},
+		{"max length", MaxLength(1), "ab", CodeMaxLength},
+		{"max items", MaxLength(1), []string{"a", "b"}, CodeMaxItems},
+		{"url", URL(), "ftp://example.com", CodeURL},
+		{"in", In("a", "b"), "c", CodeIn},
+		{"unique", Unique(), []int{1, 1}, CodeUnique},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "field")
+			var validationErr *ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.code, validationErr.Code)
+		})
+	}
+}
+
+func TestValidationError_Allocations(t *testing.T) {
+	t.Cleanup(func() { SetDefaultMessages(nil) })
+
+	min := Min(18)
+	err := min.Validate(12, "age")
+
+	t.Run("bound errors are created once per validator", func(t *testing.T) {
+		assert.Same(t, err, min.Validate(10, "age"))
+	})
+
+	t.Run("messages are rendered when displayed", func(t *testing.T) {
+		SetDefaultMessages(map[string]string{CodeMin: "doit être au moins {min}"})
+		assert.Equal(t, "doit être au moins 18", err.Error())
+	})
+}
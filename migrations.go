@@ -0,0 +1,199 @@
+package poxxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deprecation reports a legacy input key accepted through a Renamed or Moved declaration
+type Deprecation struct {
+	// Path is the legacy key or dotted path found in the input (e.g. "user.fname")
+	Path string
+	// Replacement is the key or dotted path clients should use instead
+	Replacement string
+}
+
+// String returns a human-readable deprecation warning, e.g. for a Deprecation or Warning HTTP header
+func (d Deprecation) String() string {
+	return fmt.Sprintf("%s is deprecated, use %s instead", d.Path, d.Replacement)
+}
+
+// migrationField is a declaration moving a legacy input key to its current location before assignment,
+// or setting an input key from a header of the HTTP request (see FromHeader).
+// It is declared alongside the fields (e.g. NewSchema(..., Renamed("fname", "first_name"))) but kept apart
+// from them by the schema, so it is neither assigned, validated nor described.
+type migrationField struct {
+	from        []string
+	to          []string
+	header      string
+	description string
+}
+
+// Name returns the current path of the migrated key
+func (f *migrationField) Name() string {
+	return strings.Join(f.to, ".")
+}
+
+// Value returns nil as migrations don't hold any value
+func (f *migrationField) Value() interface{} {
+	return nil
+}
+
+// Description returns the migration description
+func (f *migrationField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the migration description
+func (f *migrationField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign does nothing, the migration is run by the schema before the fields are assigned
+func (f *migrationField) Assign(data map[string]interface{}, schema *Schema) error {
+	return nil
+}
+
+// Validate does nothing as migrations don't hold any value
+func (f *migrationField) Validate(schema *Schema) error {
+	return nil
+}
+
+// Renamed declares that the top-level input key oldKey was renamed newKey.
+// Inputs still using oldKey are accepted as if they used newKey, and reported by Schema.Deprecations.
+// When both keys are given, newKey wins.
+//
+//	poxxy.NewSchema(
+//		poxxy.Value("first_name", &firstName),
+//		poxxy.Renamed("fname", "first_name"),
+//	)
+func Renamed(oldKey, newKey string) Field {
+	return &migrationField{from: []string{oldKey}, to: []string{newKey}}
+}
+
+// Moved declares that the input value at the dotted path oldPath moved to newPath (e.g. Moved("user.email", "email")
+// or Moved("zip", "address.zip")). Inputs using oldPath are accepted as if they used newPath, and reported
+// by Schema.Deprecations. When both paths are given, newPath wins.
+func Moved(oldPath, newPath string) Field {
+	return &migrationField{from: strings.Split(oldPath, "."), to: strings.Split(newPath, ".")}
+}
+
+// FromHeader declares that the input key is read from the HTTP header name when the schema is applied to
+// an HTTP request (see WithRequestHeader). The header wins over the key of the body or query, which is
+// still accepted when the header is missing.
+//
+//	poxxy.NewSchema(
+//		poxxy.Value("tenant", &tenant, poxxy.WithValidators(poxxy.Required())),
+//		poxxy.FromHeader("X-Tenant", "tenant"),
+//	)
+func FromHeader(name, key string) Field {
+	return &migrationField{to: []string{key}, header: name}
+}
+
+// splitMigrations separates the migration declarations from the fields
+func splitMigrations(fields []Field) ([]Field, []*migrationField) {
+	var migrations []*migrationField
+	kept := fields[:0:0]
+	for _, field := range fields {
+		if migration, ok := field.(*migrationField); ok {
+			migrations = append(migrations, migration)
+			continue
+		}
+
+		kept = append(kept, field)
+	}
+
+	return kept, migrations
+}
+
+// Deprecations returns the legacy keys found in the input of the last Apply, in declaration order
+func (s *Schema) Deprecations() []Deprecation {
+	return s.deprecations
+}
+
+// migrateInput returns a copy of data with the legacy keys moved to their current location and the
+// FromHeader keys set. data is returned as is when there is nothing to change.
+func (s *Schema) migrateInput(data map[string]interface{}) map[string]interface{} {
+	for _, migration := range s.migrations {
+		if migration.header != "" {
+			if value := s.header.Get(migration.header); value != "" {
+				data = setPath(data, migration.to, value)
+			}
+			continue
+		}
+
+		value, ok := getPath(data, migration.from)
+		if !ok {
+			continue
+		}
+
+		s.deprecations = append(s.deprecations, Deprecation{
+			Path:        strings.Join(migration.from, "."),
+			Replacement: strings.Join(migration.to, "."),
+		})
+
+		_, replaced := getPath(data, migration.to)
+		data = deletePath(data, migration.from)
+		if !replaced {
+			data = setPath(data, migration.to, value)
+		}
+	}
+
+	return data
+}
+
+// getPath returns the value at a path of nested objects
+func getPath(data map[string]interface{}, path []string) (interface{}, bool) {
+	for i, key := range path {
+		value, exists := data[key]
+		if !exists {
+			return nil, false
+		}
+
+		if i == len(path)-1 {
+			return value, true
+		}
+
+		if data, exists = value.(map[string]interface{}); !exists {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+// setPath returns a copy of data with value set at path, copying the objects along the path
+// and creating the missing ones
+func setPath(data map[string]interface{}, path []string, value interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(data)+1)
+	for key, v := range data {
+		result[key] = v
+	}
+
+	if len(path) == 1 {
+		result[path[0]] = value
+		return result
+	}
+
+	child, _ := result[path[0]].(map[string]interface{})
+	result[path[0]] = setPath(child, path[1:], value)
+	return result
+}
+
+// deletePath returns a copy of data without the value at path, copying the objects along the path
+func deletePath(data map[string]interface{}, path []string) map[string]interface{} {
+	result := make(map[string]interface{}, len(data))
+	for key, v := range data {
+		result[key] = v
+	}
+
+	if len(path) == 1 {
+		delete(result, path[0])
+		return result
+	}
+
+	if child, ok := result[path[0]].(map[string]interface{}); ok {
+		result[path[0]] = deletePath(child, path[1:])
+	}
+	return result
+}
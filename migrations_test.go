@@ -0,0 +1,86 @@
+package poxxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenamed(t *testing.T) {
+	var firstName string
+	schema := NewSchema(
+		Value("first_name", &firstName, WithValidators(Required())),
+		Renamed("fname", "first_name"),
+	)
+
+	data := map[string]interface{}{"fname": "John"}
+	require.NoError(t, schema.Apply(data))
+	assert.Equal(t, "John", firstName)
+	assert.Equal(t, []Deprecation{{Path: "fname", Replacement: "first_name"}}, schema.Deprecations())
+	assert.Equal(t, "fname is deprecated, use first_name instead", schema.Deprecations()[0].String())
+	assert.Equal(t, map[string]interface{}{"fname": "John"}, data, "the input must not be modified")
+
+	// The current key wins
+	require.NoError(t, schema.Apply(map[string]interface{}{"fname": "John", "first_name": "Jane"}))
+	assert.Equal(t, "Jane", firstName)
+	assert.Len(t, schema.Deprecations(), 1)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"first_name": "Jane"}))
+	assert.Empty(t, schema.Deprecations())
+}
+
+func TestMoved(t *testing.T) {
+	var email string
+	var address map[string]string
+	schema := NewSchema(
+		Value("email", &email),
+		Map("address", &address),
+		Moved("user.email", "email"),
+		Moved("zip", "address.zip"),
+	)
+
+	data := map[string]interface{}{
+		"user": map[string]interface{}{"email": "john@example.com", "name": "John"},
+		"zip":  "75001",
+	}
+
+	migrated := schema.migrateInput(data)
+	assert.Equal(t, map[string]interface{}{
+		"user":    map[string]interface{}{"name": "John"},
+		"email":   "john@example.com",
+		"address": map[string]interface{}{"zip": "75001"},
+	}, migrated)
+	assert.Equal(t, "john@example.com", data["user"].(map[string]interface{})["email"], "the input must not be modified")
+
+	require.NoError(t, schema.Apply(data))
+	assert.Equal(t, "john@example.com", email)
+	assert.Equal(t, map[string]string{"zip": "75001"}, address)
+	assert.Equal(t, []Deprecation{
+		{Path: "user.email", Replacement: "email"},
+		{Path: "zip", Replacement: "address.zip"},
+	}, schema.Deprecations())
+}
+
+func TestMigrations_NotFields(t *testing.T) {
+	schema := NewSchema(Value("first_name", new(string)), Renamed("fname", "first_name"))
+
+	assert.Len(t, schema.Describe(), 1)
+}
+
+func TestFromHeader(t *testing.T) {
+	var tenant string
+	schema := NewSchema(
+		Value("tenant", &tenant, WithValidators(Required())),
+		FromHeader("X-Tenant", "tenant"),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{}, WithRequestHeader(http.Header{"X-Tenant": {"acme"}})))
+	assert.Equal(t, "acme", tenant)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"tenant": "globex"}))
+	assert.Equal(t, "globex", tenant)
+
+	assert.EqualError(t, schema.Apply(map[string]interface{}{}), "tenant: field is required")
+}
@@ -0,0 +1,173 @@
+package poxxy
+
+import (
+	"html"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces the values of redacted fields in the normalization report
+const RedactedValue = "[REDACTED]"
+
+// Normalization describes a change made to a field value during Apply, by a transformer
+// or by an input normalization option (WithTrimStrings, WithHTMLEscape)
+type Normalization struct {
+	// Field is the name of the field, or the dotted path of the input string for input normalizations
+	Field string
+	// Transformer is the name of the transformation (e.g. "trim_space", "to_lower", "trim_strings")
+	Transformer string
+	// Before is the value before the transformation
+	Before interface{}
+	// After is the value after the transformation
+	After interface{}
+}
+
+// WithTrimStrings creates a schema option trimming leading and trailing whitespace from every string
+// of the input data, nested objects and arrays included, before conversion and validation.
+// Strings made only of whitespace become empty and are treated as missing values.
+func WithTrimStrings() SchemaOption {
+	return func(s *Schema) {
+		s.trimStrings = true
+	}
+}
+
+// WithHTMLEscape creates a schema option HTML-escaping every string of the input data
+// (<, >, &, ' and "), nested objects and arrays included, as a defense in depth for applications
+// rendering user input in templates.
+//
+// It is an output transform only: the bound values are escaped for rendering, not validated as such.
+// Escaping happens on the input, before conversion and validation, so validators see the escaped value
+// ("a&b" is 7 characters long for MaxLength, and no longer matches a Pattern without "&amp;"), and the
+// escaped value is never validated again. Fields validated on their raw content, or that must be kept
+// verbatim (e.g. rich text sanitized elsewhere, URLs), are listed as exceptions by their dotted path
+// (e.g. "bio", "user.website").
+func WithHTMLEscape(exceptions ...string) SchemaOption {
+	return func(s *Schema) {
+		s.htmlEscape = true
+		s.htmlEscapeExceptions = make(map[string]bool, len(exceptions))
+		for _, path := range exceptions {
+			s.htmlEscapeExceptions[path] = true
+		}
+	}
+}
+
+// WithNormalizationReport creates a schema option recording every change made to the field values by
+// transformers and input normalizations, available through Normalizations after Apply.
+// The values of the given fields are replaced by RedactedValue in the report (e.g. passwords, tokens).
+// The fields of sub-schemas are reported and redacted by their dotted path (e.g. "user.password"), the
+// elements of a slice sharing the path of the slice, like with input normalizations.
+func WithNormalizationReport(redactedFields ...string) SchemaOption {
+	return func(s *Schema) {
+		s.normalizationReport = true
+		s.redactedFields = make(map[string]bool, len(redactedFields))
+		for _, field := range redactedFields {
+			s.redactedFields[field] = true
+		}
+	}
+}
+
+// withNormalizationParent creates a schema option reporting the normalizations of a sub-schema to the
+// schema of its parent field, under the dotted path of its input
+func withNormalizationParent(parent *Schema, path string) SchemaOption {
+	return func(s *Schema) {
+		s.normalizationReport = true
+		s.normalizationParent = parent
+		s.normalizationPath = path
+	}
+}
+
+// Normalizations returns the changes made to the field values during the last Apply, in order.
+// It requires the WithNormalizationReport option; transformations that didn't change the value are not reported.
+func (s *Schema) Normalizations() []Normalization {
+	return s.normalizations
+}
+
+// recordNormalization adds a change to the normalization report when enabled
+func (s *Schema) recordNormalization(fieldName, transformer string, before, after interface{}) {
+	if s == nil || !s.normalizationReport || reflect.DeepEqual(before, after) {
+		return
+	}
+
+	// The changes made in sub-schemas are reported, and redacted, by the schema of the Apply
+	if s.normalizationParent != nil {
+		s.normalizationParent.recordNormalization(joinPath(s.normalizationPath, fieldName), transformer, before, after)
+		return
+	}
+
+	if s.redactedFields[fieldName] {
+		before, after = RedactedValue, RedactedValue
+	}
+
+	s.normalizations = append(s.normalizations, Normalization{
+		Field:       fieldName,
+		Transformer: transformer,
+		Before:      before,
+		After:       after,
+	})
+}
+
+// normalizeInput returns a copy of data with the input normalizations of the schema applied.
+// data is returned as is when no normalization is enabled.
+func (s *Schema) normalizeInput(data map[string]interface{}) map[string]interface{} {
+	if (!s.trimStrings && !s.htmlEscape) || data == nil {
+		return data
+	}
+
+	return mapStrings(data, "", func(path, str string) string {
+		if s.trimStrings {
+			trimmed := strings.TrimSpace(str)
+			s.recordNormalization(path, "trim_strings", str, trimmed)
+			str = trimmed
+		}
+		if s.htmlEscape && !s.htmlEscapeExceptions[path] {
+			escaped := html.EscapeString(str)
+			s.recordNormalization(path, "html_escape", str, escaped)
+			str = escaped
+		}
+		return str
+	}).(map[string]interface{})
+}
+
+// mapStrings returns a copy of value with fn applied to every string, walking through
+// nested maps and slices. path is the dotted path of the value (e.g. "user.name").
+func mapStrings(value interface{}, path string, fn func(path, str string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return fn(path, v)
+	case []string:
+		result := make([]string, len(v))
+		for i, str := range v {
+			result[i] = fn(path, str)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = mapStrings(item, path, fn)
+		}
+		return result
+	case map[string]string:
+		result := make(map[string]string, len(v))
+		for key, str := range v {
+			result[key] = fn(joinPath(path, key), str)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = mapStrings(item, joinPath(path, key), fn)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// joinPath appends a key to a dotted path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
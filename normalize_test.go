@@ -0,0 +1,169 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTrimStrings(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	var name, nickname string
+	var age int
+	var tags []string
+	var address Address
+
+	schema := NewSchema(
+		Value("name", &name, WithValidators(MaxLength(4))),
+		Value("nickname", &nickname, WithValidators(Required())),
+		Value("age", &age),
+		Slice("tags", &tags),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("city", &a.City))
+		})),
+	)
+
+	data := map[string]interface{}{
+		"name":     "  John ",
+		"nickname": "   ",
+		"age":      " 42 ",
+		"tags":     []interface{}{" go ", "rust\n"},
+		"address":  map[string]interface{}{"city": " Paris "},
+	}
+
+	err := schema.Apply(data, WithTrimStrings())
+	require.Error(t, err)
+	assert.Equal(t, "nickname: field is required", err.Error())
+
+	assert.Equal(t, "John", name)
+	assert.Equal(t, 42, age)
+	assert.Equal(t, []string{"go", "rust"}, tags)
+	assert.Equal(t, "Paris", address.City)
+
+	// The input data is not modified
+	assert.Equal(t, "  John ", data["name"])
+}
+
+func TestWithHTMLEscape(t *testing.T) {
+	type Profile struct {
+		Bio     string
+		Website string
+	}
+
+	var comment string
+	var tags []string
+	var profile Profile
+
+	schema := NewSchema(
+		Value("comment", &comment),
+		Slice("tags", &tags),
+		Struct("profile", &profile, WithSubSchema(func(s *Schema, p *Profile) {
+			WithSchema(s, Value("bio", &p.Bio))
+			WithSchema(s, Value("website", &p.Website))
+		})),
+	)
+
+	err := schema.Apply(map[string]interface{}{
+		"comment": ` <script>alert("x")</script> `,
+		"tags":    []interface{}{"a&b"},
+		"profile": map[string]interface{}{
+			"bio":     "I'm <b>here</b>",
+			"website": "https://example.com/?a=1&b=2",
+		},
+	}, WithTrimStrings(), WithHTMLEscape("profile.website"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;", comment)
+	assert.Equal(t, []string{"a&amp;b"}, tags)
+	assert.Equal(t, "I&#39;m &lt;b&gt;here&lt;/b&gt;", profile.Bio)
+	assert.Equal(t, "https://example.com/?a=1&b=2", profile.Website)
+
+	t.Run("validators see the escaped value", func(t *testing.T) {
+		var name, code string
+		schema := NewSchema(
+			Value("name", &name, WithValidators(MaxLength(5))),
+			Value("code", &code, WithValidators(MaxLength(5))),
+		)
+
+		err := schema.Apply(map[string]interface{}{"name": "a&b", "code": "a&b"}, WithHTMLEscape("code"))
+		assert.EqualError(t, err, "name: must be at most 5 characters long")
+		assert.Equal(t, "a&b", code)
+	})
+}
+
+func TestWithNormalizationReport(t *testing.T) {
+	var name, email, password string
+
+	schema := NewSchema(
+		Value("name", &name, WithTransformers(TrimSpace(), ToLower())),
+		Value("email", &email, WithTransformers(SanitizeEmail())),
+		Value("password", &password, WithTransformers(CustomTransformer(func(s string) (string, error) {
+			return s + "!", nil
+		}))),
+	)
+
+	err := schema.Apply(map[string]interface{}{
+		"name":     "JOHN ",
+		"email":    "john@example.com",
+		"password": "secret",
+	}, WithNormalizationReport("password"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Normalization{
+		{Field: "name", Transformer: "trim_space", Before: "JOHN ", After: "JOHN"},
+		{Field: "name", Transformer: "to_lower", Before: "JOHN", After: "john"},
+		{Field: "password", Transformer: "custom", Before: RedactedValue, After: RedactedValue},
+	}, schema.Normalizations())
+
+	t.Run("input normalizations", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{"name": " a&b"}, WithTrimStrings(), WithHTMLEscape(), WithNormalizationReport())
+		require.NoError(t, err)
+
+		assert.Equal(t, []Normalization{
+			{Field: "name", Transformer: "trim_strings", Before: " a&b", After: "a&b"},
+			{Field: "name", Transformer: "html_escape", Before: "a&b", After: "a&amp;b"},
+		}, schema.Normalizations())
+	})
+
+	t.Run("sub-schemas", func(t *testing.T) {
+		type User struct {
+			Name     string
+			Password string
+		}
+
+		var user User
+		var members []User
+		newUserSchema := func(s *Schema, u *User) {
+			WithSchema(s, Value("name", &u.Name, WithTransformers(ToLower())))
+			WithSchema(s, Value("password", &u.Password, WithTransformers(TrimSpace())))
+		}
+		schema := NewSchema(
+			Struct("user", &user, WithSubSchema(newUserSchema)),
+			Slice("members", &members, WithSubSchema(newUserSchema)),
+		)
+
+		err := schema.Apply(map[string]interface{}{
+			"user":    map[string]interface{}{"name": "JOHN", "password": " secret "},
+			"members": []interface{}{map[string]interface{}{"name": "Jane"}},
+		}, WithNormalizationReport("user.password"))
+		require.NoError(t, err)
+
+		assert.Equal(t, []Normalization{
+			{Field: "user.name", Transformer: "to_lower", Before: "JOHN", After: "john"},
+			{Field: "user.password", Transformer: "trim_space", Before: RedactedValue, After: RedactedValue},
+			{Field: "members.name", Transformer: "to_lower", Before: "Jane", After: "jane"},
+		}, schema.Normalizations())
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name, WithTransformers(TrimSpace())))
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"name": " John "}))
+		assert.Empty(t, schema.Normalizations())
+	})
+}
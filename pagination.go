@@ -0,0 +1,68 @@
+package poxxy
+
+// paginationConfig holds the configuration of a Pagination
+type paginationConfig struct {
+	defaultLimit int
+	maxLimit     int
+	sort         *string
+	order        *string
+	columns      []string
+}
+
+// PaginationOption represents a configuration option for Pagination
+type PaginationOption func(*paginationConfig)
+
+// MaxLimit sets the maximum number of items per page (100 by default)
+func MaxLimit(n int) PaginationOption {
+	return func(c *paginationConfig) {
+		c.maxLimit = n
+	}
+}
+
+// DefaultLimit sets the number of items per page when the input doesn't provide one (20 by default)
+func DefaultLimit(n int) PaginationOption {
+	return func(c *paginationConfig) {
+		c.defaultLimit = n
+	}
+}
+
+// WithSort adds the "sort" and "order" fields to a Pagination.
+// sort must be one of the allowed columns; order is "asc" (the default) or "desc".
+func WithSort(sort, order *string, columns ...string) PaginationOption {
+	return func(c *paginationConfig) {
+		c.sort = sort
+		c.order = order
+		c.columns = columns
+	}
+}
+
+// Pagination declares the usual pagination fields of a list endpoint:
+// "page" (at least 1, 1 by default) and "limit" (between 1 and MaxLimit, DefaultLimit by default),
+// plus "sort" and "order" with WithSort. Each field is validated and reported on its own.
+func Pagination(page, limit *int, opts ...PaginationOption) Field {
+	config := &paginationConfig{defaultLimit: 20, maxLimit: 100}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	fields := []Field{
+		Value("page", page, WithDefault(1), WithValidators(Min(1))),
+		Value("limit", limit, WithDefault(config.defaultLimit), WithValidators(Min(1), Max(config.maxLimit))),
+	}
+
+	if config.sort != nil {
+		columns := make([]interface{}, len(config.columns))
+		for i, column := range config.columns {
+			columns[i] = column
+		}
+
+		// Sorting is optional: the column is only checked when one is given
+		fields = append(fields, Value("sort", config.sort, WithValidators(When(FieldPresent("sort"), In(columns...)))))
+	}
+
+	if config.order != nil {
+		fields = append(fields, Value("order", config.order, WithDefault("asc"), WithValidators(In("asc", "desc"))))
+	}
+
+	return &compositeField{name: "pagination", fields: fields}
+}
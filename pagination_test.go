@@ -0,0 +1,70 @@
+package poxxy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagination(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		var page, limit int
+		schema := NewSchema(Pagination(&page, &limit))
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{}))
+		assert.Equal(t, 1, page)
+		assert.Equal(t, 20, limit)
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		var page, limit int
+		schema := NewSchema(Pagination(&page, &limit, MaxLimit(50), DefaultLimit(10)))
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"page": {"3"}, "limit": {"50"}}))
+		assert.Equal(t, 3, page)
+		assert.Equal(t, 50, limit)
+
+		err := schema.ApplyURLValues(url.Values{"page": {"0"}, "limit": {"51"}})
+		assert.EqualError(t, err, "page: value must be at least 1; limit: value must be at most 50")
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		var page, limit int
+		var sort, order string
+		var q string
+		schema := NewSchema(
+			Value("q", &q),
+			Pagination(&page, &limit, WithSort(&sort, &order, "name", "created_at")),
+		)
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"sort": {"name"}}))
+		assert.Equal(t, "name", sort)
+		assert.Equal(t, "asc", order)
+
+		err := schema.ApplyURLValues(url.Values{"sort": {"password"}, "order": {"up"}})
+		assert.EqualError(t, err, "sort: value password must be one of: [name created_at]; order: value up must be one of: [asc desc]")
+	})
+
+	t.Run("no sort", func(t *testing.T) {
+		var page, limit int
+		var sort, order string
+		schema := NewSchema(Pagination(&page, &limit, WithSort(&sort, &order, "name")))
+
+		require.NoError(t, schema.ApplyURLValues(url.Values{"page": {"2"}}))
+		assert.Empty(t, sort)
+		assert.Equal(t, "asc", order)
+	})
+
+	t.Run("fields are described individually", func(t *testing.T) {
+		var page, limit int
+		schema := NewSchema(Pagination(&page, &limit))
+
+		var names []string
+		for _, field := range schema.Describe() {
+			names = append(names, field.Name)
+		}
+		assert.Equal(t, []string{"page", "limit"}, names)
+	})
+}
@@ -0,0 +1,56 @@
+package poxxy
+
+import "strings"
+
+// inputlessField is implemented by the fields whose value doesn't come from their input key
+// (Computed and Rest fields). Partial applies still assign and validate them.
+type inputlessField interface {
+	ignoresInputKey()
+}
+
+// WithPartial creates a schema option for PATCH semantics: the fields missing from the input are neither
+// assigned nor validated, so their bound variables keep their current values, default values are not
+// applied and Required only applies to the fields sent with an empty value. An explicit null clears a Pointer
+// field, which is then reported as assigned (see AssignedFields). Schema rules still run.
+// Like WithContext, it only applies to the Apply it is given to.
+func WithPartial() SchemaOption {
+	return func(s *Schema) {
+		s.partial = true
+	}
+}
+
+// ApplyPartial assigns and validates the fields present in data, leaving the others untouched (see WithPartial).
+// The same schema serves the POST and PATCH endpoints of a resource, the bound variables holding its
+// current values for PATCH:
+//
+//	user := loadUser(id)
+//	schema := newUserSchema(&user)
+//	if err := schema.ApplyPartial(data); err != nil { ... }
+//	changes := schema.AssignedFields()
+func (s *Schema) ApplyPartial(data map[string]interface{}, options ...SchemaOption) error {
+	return s.Apply(data, append(options, WithPartial())...)
+}
+
+// skipsField reports whether a field is missing from the input of a partial apply
+func (s *Schema) skipsField(field Field) bool {
+	if !s.partial || isRule(field) {
+		return false
+	}
+	if _, ok := field.(inputlessField); ok {
+		return false
+	}
+
+	if _, exists := s.data[field.Name()]; exists {
+		return false
+	}
+
+	// Fields reading several keys with the bracket notation (e.g. "filter[status]")
+	prefix := field.Name() + "["
+	for key := range s.data {
+		if strings.HasPrefix(key, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
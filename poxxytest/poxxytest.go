@@ -0,0 +1,184 @@
+// Package poxxytest provides helpers to test poxxy schemas without asserting on error strings.
+package poxxytest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arkan/poxxy"
+)
+
+// UpdateGoldenEnv is the environment variable that makes AssertErrorsGolden rewrite golden files
+// instead of comparing against them (e.g. POXXYTEST_UPDATE=1 go test ./...).
+const UpdateGoldenEnv = "POXXYTEST_UPDATE"
+
+// AssertValid applies data to the schema and reports a test error if validation fails
+func AssertValid(t testing.TB, schema *poxxy.Schema, data map[string]interface{}) bool {
+	t.Helper()
+
+	if err := schema.Apply(data); err != nil {
+		t.Errorf("expected data to be valid, got: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// AssertInvalid applies data to the schema and reports a test error if validation succeeds.
+// It returns the validation errors for further assertions.
+func AssertInvalid(t testing.TB, schema *poxxy.Schema, data map[string]interface{}) error {
+	t.Helper()
+
+	err := schema.Apply(data)
+	if err == nil {
+		t.Errorf("expected data to be invalid, got no error")
+	}
+
+	return err
+}
+
+// AssertFieldError reports a test error unless err contains an error for the given field with the given code
+func AssertFieldError(t testing.TB, err error, field string, code string) bool {
+	t.Helper()
+
+	fieldErrors, ok := asErrors(t, err)
+	if !ok {
+		return false
+	}
+
+	var codes []string
+	for _, fieldError := range fieldErrors {
+		if fieldError.Field != field {
+			continue
+		}
+
+		fieldCode := errorCode(fieldError)
+		if fieldCode == code {
+			return true
+		}
+		codes = append(codes, fieldCode)
+	}
+
+	if len(codes) == 0 {
+		t.Errorf("expected an error for field %q with code %q, got no error for this field: %v", field, code, err)
+	} else {
+		t.Errorf("expected an error for field %q with code %q, got codes %v", field, code, codes)
+	}
+
+	return false
+}
+
+// AssertNoFieldError reports a test error if err contains an error for the given field
+func AssertNoFieldError(t testing.TB, err error, field string) bool {
+	t.Helper()
+
+	if err == nil {
+		return true
+	}
+
+	fieldErrors, ok := asErrors(t, err)
+	if !ok {
+		return false
+	}
+
+	for _, fieldError := range fieldErrors {
+		if fieldError.Field == field {
+			t.Errorf("expected no error for field %q, got: %v", field, fieldError.Error)
+			return false
+		}
+	}
+
+	return true
+}
+
+// AssertErrorsGolden compares a rendering of err against the golden file at path.
+// Each field error is rendered on its own line as "field: [code] message".
+// Set the POXXYTEST_UPDATE environment variable to create or update the golden file.
+func AssertErrorsGolden(t testing.TB, err error, path string) bool {
+	t.Helper()
+
+	actual := RenderErrors(err)
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if mkdirErr := os.MkdirAll(filepath.Dir(path), 0o755); mkdirErr != nil {
+			t.Fatalf("failed to create golden directory: %v", mkdirErr)
+		}
+		if writeErr := os.WriteFile(path, []byte(actual), 0o644); writeErr != nil {
+			t.Fatalf("failed to write golden file: %v", writeErr)
+		}
+		return true
+	}
+
+	expected, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Errorf("failed to read golden file (set %s=1 to create it): %v", UpdateGoldenEnv, readErr)
+		return false
+	}
+
+	if string(expected) != actual {
+		t.Errorf("errors do not match golden file %s\n--- expected\n%s--- actual\n%s", path, expected, actual)
+		return false
+	}
+
+	return true
+}
+
+// RenderErrors renders validation errors in the stable format used by golden files
+func RenderErrors(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var fieldErrors poxxy.Errors
+	if !errors.As(err, &fieldErrors) {
+		return err.Error() + "\n"
+	}
+
+	var sb strings.Builder
+	for _, fieldError := range fieldErrors {
+		if code := errorCode(fieldError); code != "" {
+			fmt.Fprintf(&sb, "%s: [%s] %v\n", fieldError.Field, code, fieldError.Error)
+		} else {
+			fmt.Fprintf(&sb, "%s: %v\n", fieldError.Field, fieldError.Error)
+		}
+	}
+
+	return sb.String()
+}
+
+// asErrors extracts poxxy.Errors from err, reporting a test error if it is not possible
+func asErrors(t testing.TB, err error) (poxxy.Errors, bool) {
+	t.Helper()
+
+	if err == nil {
+		t.Errorf("expected validation errors, got no error")
+		return nil, false
+	}
+
+	var fieldErrors poxxy.Errors
+	if !errors.As(err, &fieldErrors) {
+		t.Errorf("expected poxxy.Errors, got %T: %v", err, err)
+		return nil, false
+	}
+
+	return fieldErrors, true
+}
+
+// errorCode returns the code of a field error, falling back to the code of the wrapped validation error
+// for field errors built by hand
+func errorCode(fieldError poxxy.FieldError) string {
+	if fieldError.Code != "" {
+		return fieldError.Code
+	}
+
+	var validationErr *poxxy.ValidationError
+	if errors.As(fieldError.Error, &validationErr) {
+		return validationErr.Code
+	}
+
+	return ""
+}
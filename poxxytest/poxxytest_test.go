@@ -0,0 +1,88 @@
+package poxxytest
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/arkan/poxxy"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder captures test failures reported by the helpers
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func newUserSchema() *poxxy.Schema {
+	var name string
+	var age int
+	return poxxy.NewSchema(
+		poxxy.Value("name", &name, poxxy.WithValidators(poxxy.Required())),
+		poxxy.Value("age", &age, poxxy.WithValidators(poxxy.Min(18))),
+	)
+}
+
+func TestAssertValid(t *testing.T) {
+	assert.True(t, AssertValid(t, newUserSchema(), map[string]interface{}{"name": "John", "age": 30}))
+
+	r := &recorder{TB: t}
+	assert.False(t, AssertValid(r, newUserSchema(), map[string]interface{}{"age": 30}))
+	assert.Len(t, r.failures, 1)
+}
+
+func TestAssertFieldError(t *testing.T) {
+	err := AssertInvalid(t, newUserSchema(), map[string]interface{}{"age": 12})
+
+	assert.True(t, AssertFieldError(t, err, "name", poxxy.CodeRequired))
+	assert.True(t, AssertFieldError(t, err, "age", poxxy.CodeMin))
+
+	t.Run("custom message", func(t *testing.T) {
+		var age int
+		schema := poxxy.NewSchema(poxxy.Value("age", &age, poxxy.WithValidators(poxxy.Min(18).WithMessage("too young"))))
+		err := AssertInvalid(t, schema, map[string]interface{}{"age": 12})
+		assert.True(t, AssertFieldError(t, err, "age", poxxy.CodeMin))
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		r := &recorder{TB: t}
+		assert.False(t, AssertFieldError(r, err, "age", poxxy.CodeMax))
+		assert.Len(t, r.failures, 1)
+		assert.Contains(t, r.failures[0], "got codes [min]")
+	})
+
+	t.Run("no error for field", func(t *testing.T) {
+		r := &recorder{TB: t}
+		assert.False(t, AssertFieldError(r, err, "email", poxxy.CodeRequired))
+		assert.Len(t, r.failures, 1)
+	})
+
+	t.Run("AssertNoFieldError", func(t *testing.T) {
+		assert.True(t, AssertNoFieldError(t, err, "email"))
+
+		r := &recorder{TB: t}
+		assert.False(t, AssertNoFieldError(r, err, "age"))
+		assert.Len(t, r.failures, 1)
+	})
+}
+
+func TestAssertErrorsGolden(t *testing.T) {
+	err := AssertInvalid(t, newUserSchema(), map[string]interface{}{"age": 12})
+
+	assert.Equal(t, "name: [required] field is required\nage: [min] value must be at least 18\n", RenderErrors(err))
+	assert.True(t, AssertErrorsGolden(t, err, filepath.Join("testdata", "user_errors.golden")))
+
+	t.Run("mismatch", func(t *testing.T) {
+		r := &recorder{TB: t}
+		otherErr := AssertInvalid(t, newUserSchema(), map[string]interface{}{"name": "John", "age": 12})
+		assert.False(t, AssertErrorsGolden(r, otherErr, filepath.Join("testdata", "user_errors.golden")))
+		assert.Len(t, r.failures, 1)
+	})
+}
@@ -0,0 +1,233 @@
+package poxxy
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RuleFactory creates a validator from the arguments of a rule (e.g. ["18"] for "min:18")
+type RuleFactory func(args []string) (Validator, error)
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]RuleFactory{
+		"required":     noArgsRule(Required),
+		"not_empty":    noArgsRule(NotEmpty),
+		"email":        noArgsRule(Email),
+		"url":          noArgsRule(URL),
+		"unique":       noArgsRule(Unique),
+		"timezone":     noArgsRule(TimeZone),
+		"percent":      noArgsRule(Percent),
+		"ratio":        noArgsRule(Ratio),
+		"hex_color":    noArgsRule(HexColor),
+		"rgb_color":    noArgsRule(RGBColor),
+		"cron":         noArgsRule(CronExpr),
+		"port":         noArgsRule(func() Validator { return Port() }),
+		"host_port":    noArgsRule(func() Validator { return HostPort() }),
+		"ulid":         noArgsRule(ULID),
+		"ksuid":        noArgsRule(KSUID),
+		"ean13":        noArgsRule(EAN13),
+		"isbn":         noArgsRule(ISBN),
+		"siren":        noArgsRule(SIREN),
+		"siret":        noArgsRule(SIRET),
+		"vat_number":   noArgsRule(func() Validator { return VATNumber() }),
+		"language_tag": noArgsRule(LanguageTag),
+		"printable":    noArgsRule(Printable),
+		"no_emoji":     noArgsRule(NoEmoji),
+		"max_emoji":    lengthRule(MaxEmoji),
+		"min":          boundRule(CodeMin, CodeMinLength, CodeMinItems, func(a, b float64) bool { return a < b }),
+		"max":          boundRule(CodeMax, CodeMaxLength, CodeMaxItems, func(a, b float64) bool { return a > b }),
+		"min_length":   lengthRule(MinLength),
+		"max_length":   lengthRule(MaxLength),
+		"in":           inRule,
+		"postal_code":  postalCodeRule,
+	}
+)
+
+// RegisterRule registers a rule usable in Rules declarations.
+// Registering an existing name replaces the previous rule.
+func RegisterRule(name string, factory RuleFactory) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rules[name] = factory
+}
+
+// Rules creates a validators option from a compact rule declaration like "required|min:18|max:120".
+// Rules are separated by "|", arguments follow a ":" and are separated by ",".
+// It panics if the declaration is invalid, as rules are declared at schema construction.
+//
+// Built-in rules: required, not_empty, email, url, unique, timezone, percent, ratio, hex_color, rgb_color, cron,
+// port, host_port, ulid, ksuid, ean13, isbn, siren, siret, vat_number, language_tag, printable, no_emoji, min:N, max:N,
+// min_length:N, max_length:N, max_emoji:N, in:a,b,c, postal_code:CC.
+// min and max compare numbers by value and strings, slices and maps by length.
+func Rules(declaration string) Option {
+	validators, err := ParseRules(declaration)
+	if err != nil {
+		panic(err)
+	}
+
+	return ValidatorsOption{validators: validators}
+}
+
+// ParseRules parses a compact rule declaration like "required|min:18|max:120" into validators
+func ParseRules(declaration string) ([]Validator, error) {
+	var validators []Validator
+
+	for _, rule := range strings.Split(declaration, "|") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		name, rawArgs, hasArgs := strings.Cut(rule, ":")
+		name = strings.TrimSpace(name)
+
+		var args []string
+		if hasArgs {
+			for _, arg := range strings.Split(rawArgs, ",") {
+				args = append(args, strings.TrimSpace(arg))
+			}
+		}
+
+		rulesMu.RLock()
+		factory, ok := rules[name]
+		rulesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown rule %q", name)
+		}
+
+		validator, err := factory(args)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", name, err)
+		}
+
+		validators = append(validators, validator)
+	}
+
+	return validators, nil
+}
+
+// noArgsRule creates a rule factory for validators without arguments
+func noArgsRule(fn func() Validator) RuleFactory {
+	return func(args []string) (Validator, error) {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("expected no argument, got %d", len(args))
+		}
+
+		return fn(), nil
+	}
+}
+
+// lengthRule creates a rule factory for length validators
+func lengthRule(fn func(int) Validator) RuleFactory {
+	return func(args []string) (Validator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid length %q", args[0])
+		}
+
+		return fn(n), nil
+	}
+}
+
+// boundRule creates a rule factory comparing numbers by value and strings/collections by length.
+// fails reports whether the actual value violates the bound.
+func boundRule(code, lengthCode, itemsCode string, fails func(actual, bound float64) bool) RuleFactory {
+	return func(args []string) (Validator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+
+		bound, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", args[0])
+		}
+
+		info := ConstraintInfo{Kind: code, Params: map[string]interface{}{code: bound}}
+		return newDescribedValidator(info, func(value interface{}, fieldName string) error {
+			if valuer, ok := value.(driver.Valuer); ok {
+				vv, err := valuer.Value()
+				if err != nil {
+					return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+				}
+				value = vv
+			}
+
+			v := reflect.ValueOf(value)
+			switch v.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				if fails(float64(v.Int()), bound) {
+					return newValidationError(code, code, args[0])
+				}
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				if fails(float64(v.Uint()), bound) {
+					return newValidationError(code, code, args[0])
+				}
+			case reflect.Float32, reflect.Float64:
+				if fails(v.Float(), bound) {
+					return newValidationError(code, code, args[0])
+				}
+			case reflect.String:
+				if fails(float64(v.Len()), bound) {
+					return newValidationError(lengthCode, code, args[0])
+				}
+			case reflect.Slice, reflect.Array, reflect.Map:
+				if fails(float64(v.Len()), bound) {
+					return newValidationError(itemsCode, code, args[0])
+				}
+			}
+
+			return nil
+		}), nil
+	}
+}
+
+// postalCodeRule creates a postal code validator for the country given as argument
+func postalCodeRule(args []string) (Validator, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+	}
+
+	return PostalCode(args[0]), nil
+}
+
+// inRule creates a validator accepting values whose string representation is one of the arguments
+func inRule(args []string) (Validator, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("expected at least 1 argument")
+	}
+
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+
+	info := ConstraintInfo{Kind: "in", Params: map[string]interface{}{"values": values}}
+	return newDescribedValidator(info, func(value interface{}, fieldName string) error {
+		if valuer, ok := value.(driver.Valuer); ok {
+			vv, err := valuer.Value()
+			if err != nil {
+				return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+			}
+			value = vv
+		}
+
+		str := fmt.Sprintf("%v", value)
+		for _, arg := range args {
+			if str == arg {
+				return nil
+			}
+		}
+
+		return newValidationError(CodeIn, "value", str, "values", fmt.Sprintf("%v", args))
+	}), nil
+}
@@ -0,0 +1,124 @@
+package poxxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRules(t *testing.T) {
+	t.Run("numeric bounds", func(t *testing.T) {
+		var age int
+		schema := NewSchema(Value("age", &age, Rules("required|min:18|max:120")))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"age": 30}))
+
+		err := schema.Apply(map[string]interface{}{"age": 12})
+		assert.Error(t, err)
+		assert.Equal(t, "age: value must be at least 18", err.Error())
+
+		err = schema.Apply(map[string]interface{}{"age": 130})
+		assert.Error(t, err)
+		assert.Equal(t, "age: value must be at most 120", err.Error())
+
+		err = schema.Apply(map[string]interface{}{})
+		assert.Error(t, err)
+		assert.Equal(t, "age: field is required", err.Error())
+	})
+
+	t.Run("float bounds", func(t *testing.T) {
+		var ratio float64
+		schema := NewSchema(Value("ratio", &ratio, Rules("min:0.5")))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"ratio": 0.75}))
+		assert.Error(t, schema.Apply(map[string]interface{}{"ratio": 0.25}))
+	})
+
+	t.Run("string length bounds", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name, Rules("required | min:3 | max:5")))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"name": "John"}))
+
+		err := schema.Apply(map[string]interface{}{"name": "Al"})
+		assert.Error(t, err)
+		assert.Equal(t, "name: must be at least 3 characters long", err.Error())
+	})
+
+	t.Run("slice length bounds", func(t *testing.T) {
+		var tags []string
+		schema := NewSchema(Slice("tags", &tags, Rules("max:2|unique")))
+
+		err := schema.Apply(map[string]interface{}{"tags": []interface{}{"a", "b", "c"}})
+		assert.Error(t, err)
+		assert.Equal(t, "tags: must have at most 2 items", err.Error())
+
+		err = schema.Apply(map[string]interface{}{"tags": []interface{}{"a", "a"}})
+		assert.Error(t, err)
+		assert.Equal(t, "tags: duplicate value found: a", err.Error())
+	})
+
+	t.Run("in and email", func(t *testing.T) {
+		var status, email string
+		var level int
+		schema := NewSchema(
+			Value("status", &status, Rules("in:active,inactive")),
+			Value("level", &level, Rules("in:1,2,3")),
+			Value("email", &email, Rules("email")),
+		)
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"status": "active", "level": 2, "email": "john@example.com"}))
+
+		err := schema.Apply(map[string]interface{}{"status": "deleted", "level": 4, "email": "invalid"})
+		assert.Error(t, err)
+		assert.Equal(t, "status: value deleted must be one of: [active inactive]; level: value 4 must be one of: [1 2 3]; email: invalid email format", err.Error())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		var age int
+		schema := NewSchema(Value("age", &age, Rules("required|min:18")))
+		assert.Equal(t, []ConstraintInfo{
+			{Kind: "required"},
+			{Kind: "min", Params: map[string]interface{}{"min": float64(18)}},
+		}, schema.Describe()[0].Constraints)
+	})
+}
+
+func TestParseRules_Errors(t *testing.T) {
+	tests := []struct {
+		declaration string
+		expectedErr string
+	}{
+		{"unknown", `unknown rule "unknown"`},
+		{"min", `rule "min": expected 1 argument, got 0`},
+		{"min:abc", `rule "min": invalid number "abc"`},
+		{"min_length:x", `rule "min_length": invalid length "x"`},
+		{"required:1", `rule "required": expected no argument, got 1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.declaration, func(t *testing.T) {
+			_, err := ParseRules(tt.declaration)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+
+	assert.Panics(t, func() { Rules("unknown") })
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("even", func(args []string) (Validator, error) {
+		return ValidatorFunc(func(value int, fieldName string) error {
+			if value%2 != 0 {
+				return fmt.Errorf("must be even")
+			}
+			return nil
+		}), nil
+	})
+
+	var n int
+	schema := NewSchema(Value("n", &n, Rules("required|even")))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"n": 4}))
+	assert.EqualError(t, schema.Apply(map[string]interface{}{"n": 3}), "n: must be even")
+}
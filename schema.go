@@ -1,29 +1,87 @@
 package poxxy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 )
 
 // MaxBodySize is the maximum size of the body of an HTTP request
 // You can change this value to limit the size of the body of an HTTP request
 var MaxBodySize int64 = 5 << 20 // 5MB limit
 
-// Schema represents a validation schema
+// Schema represents a validation schema.
+//
+// A schema writes to the variables bound when it was built, so a schema built with NewSchema is not safe for
+// concurrent use. Build a SchemaOf once instead, and Bind it to the variables of each request
+// (see the Concurrency section of the README).
 type Schema struct {
-	fields         []Field
+	fields     []Field
+	migrations []*migrationField
+	evaluation
+}
+
+// evaluation holds the state of an Apply: the options it was given and what it read and reported.
+// It is reset by each Apply, so the fields are the only state a schema keeps between two Apply.
+type evaluation struct {
 	data           map[string]interface{}
 	presentFields  map[string]bool // Track which fields were present in input data
 	skipValidators bool
+	// Skip the validation of the fields whose assignment failed
+	skipValidationOnAssignError bool
+	// Reject NaN and ±Inf in float fields
+	rejectNonFinite bool
+	// Reject the input keys not declared in the schema, sub-schemas included
+	disallowUnknownFields bool
+	translator            Translator
+	trimStrings           bool
+	htmlEscape            bool
+	// Dotted paths of the strings left unescaped by WithHTMLEscape
+	htmlEscapeExceptions map[string]bool
+	normalizationReport  bool
+	redactedFields       map[string]bool
+	normalizations       []Normalization
+	ctx                  context.Context
+	timingsEnabled       bool
+	timings              []FieldTiming
+	allErrors            bool           // Run every validator of a field (see WithAllErrors)
+	partial              bool           // Skip the fields missing from the input (see WithPartial)
+	skipped              map[Field]bool // Fields missing from the input of the last partial Apply
+	envelope             []string       // Path of the object holding the fields (see WithEnvelope)
+	rawBodyCapture       io.Writer
+	header               http.Header // Headers of the HTTP request, read by FromHeader declarations
+	deprecations         []Deprecation
+	// Context of the event carried by the errors of ApplyEvent (see WithEventFields)
+	eventFields map[string]interface{}
+	// Input keys read outside of the fields, e.g. the version field of a VersionedSchema
+	extraKeys []string
+	// Schema reporting the normalizations of this sub-schema, under its dotted path
+	normalizationParent *Schema
+	normalizationPath   string
 }
 
-// NewSchema creates a new schema with the given fields
+// NewSchema creates a new schema with the given fields.
+// It panics if the dependencies declared with After are invalid (unknown field or cycle),
+// as they are programmer mistakes.
 func NewSchema(fields ...Field) *Schema {
-	return &Schema{
-		fields:        fields,
-		presentFields: make(map[string]bool),
+	fields, migrations := splitMigrations(expandFields(fields))
+	schema := &Schema{
+		fields:     fields,
+		migrations: migrations,
+		evaluation: evaluation{presentFields: make(map[string]bool)},
+	}
+
+	if err := schema.CheckDependencies(); err != nil {
+		panic(err)
 	}
+
+	return schema
 }
 
 // SchemaOption represents a configuration option for a schema
@@ -36,6 +94,113 @@ func WithSkipValidators(skipValidators bool) SchemaOption {
 	}
 }
 
+// WithSkipValidationOnAssignError creates a schema option skipping the validation of the fields whose
+// assignment failed, so a conversion failure (e.g. "not a number") isn't followed by a Required or Min error
+// for the same field. Rule errors reported for these fields are dropped too.
+func WithSkipValidationOnAssignError() SchemaOption {
+	return func(s *Schema) {
+		s.skipValidationOnAssignError = true
+	}
+}
+
+// WithDisallowUnknownFields creates a schema option rejecting the input keys which are not declared in the
+// schema (e.g. a misspelled "emial"), each with an "unknown_field" error. Keys using the bracket notation of
+// a declared field ("filter[status]") are accepted. Unlike the other schema options, it applies to the
+// sub-schemas of Struct, Pointer, Slice, Map and HTTPMap fields.
+func WithDisallowUnknownFields() SchemaOption {
+	return func(s *Schema) {
+		s.disallowUnknownFields = true
+	}
+}
+
+// WithAllErrors creates a schema option running every validator of a field instead of stopping at the first
+// failure, so a value breaking several constraints gets an error for each of them. Validators performing I/O
+// (see Remote) still only run when the other validators pass. It applies to sub-schemas too.
+func WithAllErrors() SchemaOption {
+	return func(s *Schema) {
+		s.allErrors = true
+	}
+}
+
+// WithContext creates a schema option setting the context passed to context-aware validators
+// (see ContextValidator) during Apply
+func WithContext(ctx context.Context) SchemaOption {
+	return func(s *Schema) {
+		s.ctx = ctx
+	}
+}
+
+// WithRawBodyCapture creates a schema option copying the request body consumed by ApplyHTTPRequest to w
+// (e.g. a *bytes.Buffer), for auditing, logging or signature checks. The bytes are captured as received,
+// before decompression. Avoid it with large multipart uploads, which would then be buffered in w.
+// It has no effect on the other Apply methods.
+func WithRawBodyCapture(w io.Writer) SchemaOption {
+	return func(s *Schema) {
+		s.rawBodyCapture = w
+	}
+}
+
+// withExtraKeys creates a schema option accepting input keys read outside of the fields
+// with WithDisallowUnknownFields
+func withExtraKeys(keys ...string) SchemaOption {
+	return func(s *Schema) {
+		s.extraKeys = keys
+	}
+}
+
+// WithRequestHeader creates a schema option giving the headers of the HTTP request to the FromHeader
+// declarations. ApplyHTTPRequest, ApplyForm and ApplyMultipart set it from the request.
+func WithRequestHeader(header http.Header) SchemaOption {
+	return func(s *Schema) {
+		s.header = header
+	}
+}
+
+// ApplyContext assigns data to variables and validates them like Apply, passing ctx to the
+// context-aware validators (see ContextValidator), sub-schemas included. Validation stops when ctx
+// is canceled or its deadline is exceeded, and ctx.Err() is returned instead of the validation errors.
+func (s *Schema) ApplyContext(ctx context.Context, data map[string]interface{}, options ...SchemaOption) error {
+	return s.Apply(data, append(options, WithContext(ctx))...)
+}
+
+// subSchemaOptions returns the options of the current Apply inherited by the sub-schemas of the fields.
+// path is the dotted path of the sub-schema input (e.g. "address"), prefixing its normalizations.
+func (s *Schema) subSchemaOptions(path string) []SchemaOption {
+	options := []SchemaOption{WithContext(s.Context())}
+	if s.disallowUnknownFields {
+		options = append(options, WithDisallowUnknownFields())
+	}
+	if s.allErrors {
+		options = append(options, WithAllErrors())
+	}
+	if s.rejectNonFinite {
+		options = append(options, WithRejectNonFinite())
+	}
+	if s.normalizationReport {
+		options = append(options, withNormalizationParent(s, path))
+	}
+
+	return options
+}
+
+// contextErr returns the error of the context of the current Apply, if any
+func (s *Schema) contextErr() error {
+	if s.ctx == nil {
+		return nil
+	}
+
+	return s.ctx.Err()
+}
+
+// Context returns the context of the current Apply, or context.Background() if none was set
+func (s *Schema) Context() context.Context {
+	if s == nil || s.ctx == nil {
+		return context.Background()
+	}
+
+	return s.ctx
+}
+
 type ContentTypeParsing uint8
 
 const (
@@ -44,15 +209,22 @@ const (
 	ContentTypeParsingJSON
 	ContentTypeParsingForm
 	ContentTypeParsingQuery
+	ContentTypeParsingMultipart
 )
 
 type HTTPRequestOption struct {
 	MaxRequestBodySize int64
 	ContentTypeParsing ContentTypeParsing
+	// Decompress enables bodies sent with a gzip or deflate Content-Encoding.
+	// MaxRequestBodySize then limits the compressed body.
+	Decompress bool
+	// MaxDecompressedBodySize limits the decompressed body when Decompress is enabled, protecting against
+	// decompression bombs. It defaults to MaxBodySize.
+	MaxDecompressedBodySize int64
 }
 
 // ApplyHTTPRequest assigns data from an HTTP request to a schema
-// It supports application/json and application/x-www-form-urlencoded
+// It supports application/json, application/x-www-form-urlencoded and multipart/form-data (see ApplyMultipart)
 // It will return an error if the content type is not supported
 func (s *Schema) ApplyHTTPRequest(w http.ResponseWriter, r *http.Request, httpRequestOption *HTTPRequestOption, options ...SchemaOption) error {
 	if httpRequestOption == nil {
@@ -62,65 +234,69 @@ func (s *Schema) ApplyHTTPRequest(w http.ResponseWriter, r *http.Request, httpRe
 		}
 	}
 
+	// Options given by the caller win over the headers of the request
+	options = append([]SchemaOption{WithRequestHeader(r.Header)}, options...)
+
 	// Determine the content type parsing strategy depending on the content type header.
 	// We only do this for ContentTypeParsingAuto.
 	if httpRequestOption.ContentTypeParsing == ContentTypeParsingAuto {
-		switch r.Header.Get("Content-Type") {
-		case "application/json":
+		switch contentType := r.Header.Get("Content-Type"); {
+		case contentType == "application/json":
 			httpRequestOption.ContentTypeParsing = ContentTypeParsingJSON
-		case "application/x-www-form-urlencoded":
+		case contentType == "application/x-www-form-urlencoded":
 			httpRequestOption.ContentTypeParsing = ContentTypeParsingForm
+		case strings.HasPrefix(contentType, "multipart/form-data"):
+			httpRequestOption.ContentTypeParsing = ContentTypeParsingMultipart
 		default:
 			httpRequestOption.ContentTypeParsing = ContentTypeParsingQuery
 		}
 	}
 
+	// The options are applied before the body is read, which they may capture (see WithRawBodyCapture)
+	s.configure(options)
+
 	// Apply the content type parsing strategy.
+	var data map[string]interface{}
 	switch httpRequestOption.ContentTypeParsing {
 	case ContentTypeParsingForm:
-		if httpRequestOption.MaxRequestBodySize > 0 {
-			// Limit the request body size
-			r.Body = http.MaxBytesReader(w, r.Body, httpRequestOption.MaxRequestBodySize)
+		if err := limitRequestBody(w, r, httpRequestOption, s.rawBodyCapture); err != nil {
+			return err
 		}
 
 		if err := r.ParseForm(); err != nil {
 			return fmt.Errorf("failed to parse form: %w", err)
 		}
 
-		form := make(map[string]interface{})
-
 		// Note: we are using Postform and not Form because we don't want to include
 		// the data from the url query params.
 		// See: https://pkg.go.dev/net/http#Request.PostForm
-		for key, values := range r.PostForm {
-			// We only support the first value of each form field
-			form[key] = values[0]
+		data = s.urlValuesData(r.PostForm)
+	case ContentTypeParsingMultipart:
+		if err := limitRequestBody(w, r, httpRequestOption, s.rawBodyCapture); err != nil {
+			return err
 		}
 
-		return s.Apply(form, options...)
+		multipartData, err := s.multipartData(r)
+		if err != nil {
+			return err
+		}
+		data = multipartData
 	case ContentTypeParsingJSON:
-		if httpRequestOption.MaxRequestBodySize > 0 {
-			// Limit the request body size
-			r.Body = http.MaxBytesReader(w, r.Body, httpRequestOption.MaxRequestBodySize)
+		if err := limitRequestBody(w, r, httpRequestOption, s.rawBodyCapture); err != nil {
+			return err
 		}
 
-		var data map[string]interface{}
 		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
 			return fmt.Errorf("failed to unmarshal request body: %w", err)
 		}
-
-		return s.Apply(data, options...)
 	default:
 		// If the content type parsing strategy is not set, we fall through to the default case ContentTypeParsingQuery.
 		fallthrough
 	case ContentTypeParsingQuery:
-		params := make(map[string]interface{})
-		for key, values := range r.URL.Query() {
-			params[key] = values[0]
-		}
-
-		return s.Apply(params, options...)
+		data = s.urlValuesData(r.URL.Query())
 	}
+
+	return s.apply(data)
 }
 
 // ApplyJSON assigns data from a JSON string to a schema
@@ -134,15 +310,81 @@ func (s *Schema) ApplyJSON(jsonData []byte, options ...SchemaOption) error {
 	return s.Apply(data, options...)
 }
 
+// ApplyJSONArray assigns a JSON document whose root is an array (e.g. a batch of items) to ptr.
+// Each object element is applied to the sub-schema built by callback, like the elements of a Slice field;
+// callback may be nil for arrays of scalars, which are converted to T. Errors have an empty field name
+// and are located by their index once flattened (e.g. "[1].name").
+//
+//	var items []Item
+//	err := poxxy.ApplyJSONArray(body, &items, func(s *poxxy.Schema, item *Item) {
+//		poxxy.WithSchema(s, poxxy.Value("name", &item.Name, poxxy.WithValidators(poxxy.Required())))
+//	})
+func ApplyJSONArray[T any](jsonData []byte, ptr *[]T, callback func(*Schema, *T), options ...SchemaOption) error {
+	var data []interface{}
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal request body: %w", err)
+	}
+
+	field := &SliceField[T]{ptr: ptr, callback: callback}
+	return NewSchema(field).Apply(map[string]interface{}{"": data}, options...)
+}
+
+// ApplyJSONValue assigns a JSON document whose root is not an object keyed by field names to ptr, e.g. a
+// webhook payload. An object root is applied to the sub-schema built by callback, like a Struct field;
+// with a nil callback, a scalar root (string, number, boolean) is converted to T like a Value field.
+// Errors have an empty field name, so the errors of an object are reported by their own field names.
+//
+//	var event WebhookEvent
+//	err := poxxy.ApplyJSONValue(body, &event, func(s *poxxy.Schema, e *WebhookEvent) {
+//		poxxy.WithSchema(s, poxxy.Value("type", &e.Type, poxxy.WithValidators(poxxy.Required())))
+//	})
+func ApplyJSONValue[T any](jsonData []byte, ptr *T, callback func(*Schema, *T), options ...SchemaOption) error {
+	var data interface{}
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal request body: %w", err)
+	}
+
+	var field Field = &ValueField[T]{ptr: ptr}
+	if callback != nil {
+		field = &StructField[T]{ptr: ptr, callback: callback}
+	}
+
+	return NewSchema(field).Apply(map[string]interface{}{"": data}, options...)
+}
+
 // Apply assigns data to variables and validates them
 func (s *Schema) Apply(data map[string]interface{}, options ...SchemaOption) error {
-	s.data = data
-	s.presentFields = make(map[string]bool)
+	s.configure(options)
+	return s.apply(data)
+}
+
+// configure resets the state of the previous Apply and applies the options to the schema
+func (s *Schema) configure(options []SchemaOption) {
+	// Options only hold for the Apply they are given to
+	s.evaluation = evaluation{presentFields: make(map[string]bool)}
 
 	// Apply options to the schema
 	for _, option := range options {
 		option(s)
 	}
+}
+
+// apply assigns data to the schema configured by configure, and validates it
+func (s *Schema) apply(data map[string]interface{}) error {
+	statApplies.Add(1)
+
+	if s.envelope != nil {
+		inner, err := s.unwrapEnvelope(data)
+		if err != nil {
+			return err
+		}
+		data = inner
+	}
+
+	data = s.migrateInput(data)
+	data = s.normalizeInput(data)
+	s.data = data
+	timings := s.startTimings()
 
 	// Track which top-level fields are present
 	for key := range data {
@@ -150,40 +392,267 @@ func (s *Schema) Apply(data map[string]interface{}, options ...SchemaOption) err
 	}
 
 	var errors Errors
+	var assignFailed map[Field]bool
 
-	// First pass: assign values
-	for _, field := range s.fields {
-		if err := field.Assign(data, s); err != nil {
+	if s.disallowUnknownFields {
+		errors = s.unknownFieldErrors(data)
+	}
+
+	// First pass: assign values, dependencies first
+	for _, field := range s.assignmentOrder() {
+		if s.skipsField(field) {
+			if s.skipped == nil {
+				s.skipped = make(map[Field]bool)
+			}
+			s.skipped[field] = true
+			continue
+		}
+
+		var start time.Time
+		if timings != nil {
+			start = time.Now()
+		}
+		err := field.Assign(data, s)
+		if i, ok := timings[field]; ok {
+			s.timings[i].Assign = time.Since(start)
+		}
+
+		if err != nil {
 			errors = append(errors, FieldError{Field: field.Name(), Error: err, Description: field.Description()})
+			if assignFailed == nil {
+				assignFailed = make(map[Field]bool)
+			}
+			assignFailed[field] = true
 		}
 	}
+	assignErrors := len(errors)
 
 	// If we skip validators, return any assignment errors
 	if s.skipValidators {
 		if len(errors) > 0 {
-			return errors
+			return s.finishErrors(errors)
 		}
 		return nil
 	}
 
 	// Second pass: validate (even if there were assignment errors)
-	for _, field := range s.fields {
-		if err := field.Validate(s); err != nil {
+	for _, field := range s.validationOrder() {
+		// Stop validating once the context is canceled, e.g. when the client went away
+		if err := s.contextErr(); err != nil {
+			return err
+		}
+
+		if s.skipValidationOnAssignError && assignFailed[field] || s.skipped[field] {
+			continue
+		}
+
+		if s.rejectNonFinite {
+			if err := checkFinite(field.Value()); err != nil {
+				errors = append(errors, FieldError{Field: field.Name(), Error: err, Description: field.Description()})
+				continue
+			}
+		}
+
+		var start time.Time
+		if timings != nil {
+			start = time.Now()
+		}
+		err := field.Validate(s)
+		if i, ok := timings[field]; ok {
+			s.timings[i].Validate = time.Since(start)
+		}
+
+		if err != nil {
+			// Rules spanning several fields attribute their errors themselves
+			if ruleErrors, ok := err.(Errors); ok && isRule(field) {
+				errors = append(errors, ruleErrors...)
+				continue
+			}
+
+			// Every failure of the field is reported with WithAllErrors
+			if failures, ok := err.(validatorErrors); ok {
+				for _, failure := range failures {
+					errors = append(errors, FieldError{Field: field.Name(), Error: failure, Description: field.Description()})
+				}
+				continue
+			}
+
 			errors = append(errors, FieldError{Field: field.Name(), Error: err, Description: field.Description()})
 		}
 	}
 
+	if err := s.contextErr(); err != nil {
+		return err
+	}
+
+	errors = markSecondaryErrors(errors, assignErrors)
+	if s.skipValidationOnAssignError {
+		// Rules may still report errors for the fields whose assignment failed
+		errors = errors.Primary()
+	}
+
 	// Return all errors (assignment + validation)
 	if len(errors) > 0 {
-		return errors
+		return s.finishErrors(errors)
 	}
 
 	return nil
 }
 
+// unknownFieldErrors returns an error for each key of data not declared in the schema, sorted by key.
+// There is none when a Rest field collects them.
+func (s *Schema) unknownFieldErrors(data map[string]interface{}) Errors {
+	for _, field := range s.fields {
+		if isRestField(field) {
+			return nil
+		}
+	}
+
+	var unknown []string
+	for key := range data {
+		if !s.declaresKey(key) && !slices.Contains(s.extraKeys, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+
+	var errors Errors
+	for _, key := range unknown {
+		errors = append(errors, FieldError{Field: key, Error: newValidationError(CodeUnknownField)})
+	}
+
+	return errors
+}
+
+// declaresKey reports whether an input key is read by a field of the schema,
+// directly or with the bracket notation (e.g. "filter[status]")
+func (s *Schema) declaresKey(key string) bool {
+	name, _, _ := strings.Cut(key, "[")
+	for _, field := range s.fields {
+		if !isRule(field) && !isRestField(field) && field.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// markSecondaryErrors marks the validation errors of the fields whose assignment failed as secondary,
+// and drops the errors repeating the message of a previous error of the same field.
+// The first assignErrors errors are the assignment errors.
+func markSecondaryErrors(errors Errors, assignErrors int) Errors {
+	if len(errors) < 2 {
+		return errors
+	}
+
+	counts := make(map[string]int, len(errors))
+	for _, fieldError := range errors {
+		counts[fieldError.Field]++
+	}
+
+	failed := make(map[string]bool, assignErrors)
+	for _, fieldError := range errors[:assignErrors] {
+		failed[fieldError.Field] = true
+	}
+
+	// Messages are only rendered for the fields with several errors
+	type message struct{ field, msg string }
+	seen := make(map[message]bool)
+	result := errors[:0]
+	for i, fieldError := range errors {
+		if counts[fieldError.Field] > 1 {
+			key := message{fieldError.Field, fieldError.Error.Error()}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+
+		if i >= assignErrors && failed[fieldError.Field] {
+			fieldError.Secondary = true
+		}
+		result = append(result, fieldError)
+	}
+
+	return result
+}
+
+// finishErrors completes the errors returned by Apply: details, envelope paths, translation and order
+func (s *Schema) finishErrors(errors Errors) Errors {
+	statFieldErrors.Add(uint64(len(errors)))
+	setErrorDetails(errors)
+	s.prefixEnvelope(errors)
+	s.translateErrors(errors)
+	s.sortErrors(errors)
+	return errors
+}
+
+// setErrorDetails sets the Path of the errors to their field name and their Code, unless already set
+func setErrorDetails(errors Errors) {
+	for i := range errors {
+		errors[i].Path = errors[i].path()
+		if errors[i].Code == "" {
+			errors[i].Code = validationErrorCode(errors[i].Error)
+		}
+	}
+}
+
+// sortErrors sorts errors by field declaration order, and the element errors of each field by index.
+// The sort is stable: the assignment error of a field stays before its validation errors.
+// Errors of fields unknown to the schema (e.g. reported by a rule) go last.
+func (s *Schema) sortErrors(errors Errors) {
+	for _, fieldError := range errors {
+		if elementErrors, ok := fieldError.Error.(ElementErrors); ok {
+			sort.SliceStable(elementErrors, func(i, j int) bool {
+				return elementErrors[i].Index < elementErrors[j].Index
+			})
+		}
+	}
+
+	if len(errors) < 2 {
+		return
+	}
+
+	positions := make(map[string]int, len(s.fields))
+	for i, field := range s.fields {
+		if _, exists := positions[field.Name()]; !exists && !isRule(field) {
+			positions[field.Name()] = i
+		}
+	}
+
+	position := func(fieldError FieldError) int {
+		if i, ok := positions[fieldError.Field]; ok {
+			return i
+		}
+		return len(s.fields)
+	}
+
+	sort.SliceStable(errors, func(i, j int) bool {
+		return position(errors[i]) < position(errors[j])
+	})
+}
+
+// MustApply is like Apply but panics if the data is invalid.
+// It is intended for tests, fixtures and startup configuration where errors are programmer mistakes.
+func (s *Schema) MustApply(data map[string]interface{}, options ...SchemaOption) {
+	if err := s.Apply(data, options...); err != nil {
+		panic(err)
+	}
+}
+
+// MustApplyJSON is like ApplyJSON but panics if the JSON is malformed or invalid.
+func (s *Schema) MustApplyJSON(jsonData []byte, options ...SchemaOption) {
+	if err := s.ApplyJSON(jsonData, options...); err != nil {
+		panic(err)
+	}
+}
+
 // GetFieldValue returns the value of a field by name
 func (s *Schema) GetFieldValue(fieldName string) (interface{}, bool) {
 	for _, field := range s.fields {
+		if isRule(field) {
+			continue
+		}
 		if f, ok := field.(Field); ok && f.Name() == fieldName {
 			return f.Value(), true
 		}
@@ -205,7 +674,9 @@ func (s *Schema) SetFieldPresent(fieldName string) {
 
 // WithSchema adds a field to a schema
 func WithSchema(schema *Schema, field Field) {
-	schema.fields = append(schema.fields, field)
+	fields, migrations := splitMigrations(expandFields([]Field{field}))
+	schema.fields = append(schema.fields, fields...)
+	schema.migrations = append(schema.migrations, migrations...)
 }
 
 // SubSchemaOption holds a callback for configuring sub-schemas
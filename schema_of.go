@@ -0,0 +1,140 @@
+package poxxy
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// boundField is implemented by the fields which can be bound to the variables of each Apply of a SchemaOf
+type boundField interface {
+	// target returns the variable assigned by the field, nil when it doesn't assign one
+	target() interface{}
+	// bind returns a copy of the field assigning ptr instead
+	bind(ptr interface{}) Field
+}
+
+// SchemaOf is a schema built once for the variables of type T, e.g. at startup, and bound to new variables
+// for each Apply. Unlike a Schema, it is safe for concurrent use: Bind returns a schema of its own for each
+// Apply, holding the state of the Apply, while the validators and the checks of the declaration are shared.
+//
+//	var userSchema = poxxy.NewSchemaOf(func(s *poxxy.Schema, u *User) {
+//		poxxy.WithSchema(s, poxxy.Value("name", &u.Name, poxxy.WithValidators(poxxy.Required())))
+//	})
+//
+//	func createUser(w http.ResponseWriter, r *http.Request) {
+//		var user User
+//		if err := userSchema.Bind(&user).ApplyHTTPRequest(w, r, nil); err != nil {
+//			// ...
+//		}
+//	}
+type SchemaOf[T any] struct {
+	fields     []Field
+	migrations []*migrationField
+	// Indexes of the struct fields leading to the variables of the fields, empty for T itself and nil for
+	// the fields without variable
+	indexes [][]int
+}
+
+// NewSchemaOf creates a schema for the variables of type T, declared by callback like a sub-schema.
+// callback is called once, with a variable which is never applied: the fields must be bound to T or to
+// its exported fields, and functions reading other fields (Computed, WithDefaultFunc, rules, ...) must read
+// them from the schema they are given rather than from the variable.
+// Like NewSchema, it panics if the dependencies declared with After are invalid, and it panics if a field
+// isn't bound to T or doesn't support being bound to other variables (e.g. a custom Field).
+func NewSchemaOf[T any](callback func(*Schema, *T)) *SchemaOf[T] {
+	var prototype T
+	schema := NewSchema()
+	callback(schema, &prototype)
+
+	if err := schema.CheckDependencies(); err != nil {
+		panic(err)
+	}
+
+	indexes := make([][]int, len(schema.fields))
+	for i, field := range schema.fields {
+		if isRule(field) {
+			continue
+		}
+
+		bound, ok := field.(boundField)
+		if !ok {
+			panic(fmt.Sprintf("NewSchemaOf doesn't support %T", field))
+		}
+		if bound.target() == nil {
+			continue
+		}
+
+		index, ok := variableIndex(reflect.ValueOf(&prototype).Elem(), reflect.ValueOf(bound.target()))
+		if !ok {
+			panic(fmt.Sprintf("field %q of NewSchemaOf isn't bound to %T or to one of its exported fields", field.Name(), prototype))
+		}
+		indexes[i] = index
+	}
+
+	return &SchemaOf[T]{
+		fields:     schema.fields,
+		migrations: schema.migrations,
+		indexes:    indexes,
+	}
+}
+
+// Bind returns a schema assigning ptr, for a single Apply. The schemas returned by Bind can be applied
+// concurrently, each of them holding the state of its Apply (IsFieldPresent, Normalizations, Timings, ...).
+func (t *SchemaOf[T]) Bind(ptr *T) *Schema {
+	root := reflect.ValueOf(ptr).Elem()
+
+	fields := make([]Field, len(t.fields))
+	for i, field := range t.fields {
+		bound, ok := field.(boundField)
+		if !ok {
+			// Rules don't hold any state
+			fields[i] = field
+			continue
+		}
+
+		if t.indexes[i] == nil {
+			fields[i] = bound.bind(nil)
+			continue
+		}
+
+		variable := root
+		for _, index := range t.indexes[i] {
+			variable = variable.Field(index)
+		}
+		fields[i] = bound.bind(variable.Addr().Interface())
+	}
+
+	return &Schema{
+		fields:     fields,
+		migrations: t.migrations,
+		evaluation: evaluation{presentFields: make(map[string]bool)},
+	}
+}
+
+// variableIndex returns the index in root of the variable ptr points to, looking into the exported fields
+// of root and of its nested structs
+func variableIndex(root reflect.Value, ptr reflect.Value) ([]int, bool) {
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+		return nil, false
+	}
+
+	if root.Addr().Pointer() == ptr.Pointer() && root.Type() == ptr.Type().Elem() {
+		return []int{}, true
+	}
+
+	if root.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	for i := 0; i < root.NumField(); i++ {
+		if !root.Type().Field(i).IsExported() {
+			continue
+		}
+
+		if index, ok := variableIndex(root.Field(i), ptr); ok {
+			return append([]int{i}, index...), true
+		}
+	}
+
+	return nil, false
+}
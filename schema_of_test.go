@@ -0,0 +1,145 @@
+package poxxy
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaOfAddress struct {
+	City string
+	Zip  string
+}
+
+type schemaOfUser struct {
+	Name     string
+	Age      int
+	Nickname *string
+	Address  schemaOfAddress
+	Tags     []schemaOfTag
+	Greeting string
+}
+
+type schemaOfTag struct {
+	Label string
+}
+
+var schemaOfUsers = NewSchemaOf(func(s *Schema, u *schemaOfUser) {
+	WithSchema(s, Value("name", &u.Name, WithValidators(Required(), MinLength(2))))
+	WithSchema(s, Value("age", &u.Age, WithDefault(18)))
+	WithSchema(s, Pointer("nickname", &u.Nickname))
+	WithSchema(s, Value("zip", &u.Address.Zip))
+	WithSchema(s, Slice("tags", &u.Tags, WithSubSchema(func(s *Schema, tag *schemaOfTag) {
+		WithSchema(s, Value("label", &tag.Label, WithValidators(Required())))
+	})))
+	WithSchema(s, Computed("greeting", &u.Greeting, func(s *Schema) (string, error) {
+		name, _ := s.GetFieldValue("name")
+		return fmt.Sprintf("Hello %v", name), nil
+	}))
+})
+
+func TestSchemaOf(t *testing.T) {
+	t.Run("binds the fields to each variable", func(t *testing.T) {
+		var john, jane schemaOfUser
+
+		johnSchema := schemaOfUsers.Bind(&john)
+		err := johnSchema.Apply(map[string]interface{}{
+			"name":     "John",
+			"nickname": "Johnny",
+			"zip":      "75001",
+			"tags":     []interface{}{map[string]interface{}{"label": "admin"}},
+		})
+		require.NoError(t, err)
+
+		janeSchema := schemaOfUsers.Bind(&jane)
+		err = janeSchema.Apply(map[string]interface{}{"name": "Jane", "age": 30})
+		require.NoError(t, err)
+
+		nickname := "Johnny"
+		assert.Equal(t, schemaOfUser{
+			Name:     "John",
+			Age:      18,
+			Nickname: &nickname,
+			Address:  schemaOfAddress{Zip: "75001"},
+			Tags:     []schemaOfTag{{Label: "admin"}},
+			Greeting: "Hello John",
+		}, john)
+		assert.Equal(t, schemaOfUser{Name: "Jane", Age: 30, Greeting: "Hello Jane"}, jane)
+
+		// Each bound schema holds the state of its own Apply
+		assert.True(t, johnSchema.IsFieldPresent("zip"))
+		assert.False(t, janeSchema.IsFieldPresent("zip"))
+		johnAge, _ := johnSchema.FieldState("age")
+		janeAge, _ := janeSchema.FieldState("age")
+		assert.True(t, johnAge.Defaulted)
+		assert.False(t, janeAge.Defaulted)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var user schemaOfUser
+		err := schemaOfUsers.Bind(&user).Apply(map[string]interface{}{
+			"name": "J",
+			"tags": []interface{}{map[string]interface{}{}},
+		})
+		assert.EqualError(t, err, "name: must be at least 2 characters long; tags: element 0: label: field is required")
+	})
+
+	t.Run("variable of the schema type", func(t *testing.T) {
+		ids := NewSchemaOf(func(s *Schema, id *int) {
+			WithSchema(s, Value("id", id, WithValidators(Min(1))))
+		})
+
+		var id int
+		require.NoError(t, ids.Bind(&id).Apply(map[string]interface{}{"id": "42"}))
+		assert.Equal(t, 42, id)
+	})
+
+	t.Run("fields not bound to the variable", func(t *testing.T) {
+		var other string
+		assert.PanicsWithValue(t, `field "name" of NewSchemaOf isn't bound to poxxy.schemaOfUser or to one of its exported fields`, func() {
+			NewSchemaOf(func(s *Schema, u *schemaOfUser) {
+				WithSchema(s, Value("name", &other))
+			})
+		})
+
+		assert.PanicsWithValue(t, "NewSchemaOf doesn't support *poxxy.compositeField", func() {
+			NewSchemaOf(func(s *Schema, u *schemaOfUser) {
+				s.fields = append(s.fields, &compositeField{name: "group"})
+			})
+		})
+	})
+}
+
+func TestSchemaOf_Concurrent(t *testing.T) {
+	// Run with -race: the bound schemas must not share any state
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				name := fmt.Sprintf("user-%d-%d", i, j)
+
+				var user schemaOfUser
+				schema := schemaOfUsers.Bind(&user)
+				err := schema.Apply(map[string]interface{}{
+					"name": name,
+					"age":  j,
+					"tags": []interface{}{map[string]interface{}{"label": name}},
+				}, WithTrimStrings(), WithNormalizationReport())
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				assert.Equal(t, schemaOfUser{Name: name, Age: j, Tags: []schemaOfTag{{Label: name}}, Greeting: "Hello " + name}, user)
+				assert.True(t, schema.IsFieldPresent("tags"))
+				assert.False(t, schema.IsFieldPresent("zip"))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,379 @@
+package poxxy
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ruleField is a pseudo-field validating several fields of a schema at once.
+// It is declared alongside the fields (e.g. NewSchema(..., DateRange("start", "end")))
+// and runs during the validation pass, its errors being attached to the field named name.
+type ruleField struct {
+	name        string
+	description string
+	check       func(schema *Schema) error
+	last        bool // Run once every field is validated
+}
+
+// Name returns the name of the field the rule errors are attached to
+func (f *ruleField) Name() string {
+	return f.name
+}
+
+// Value returns nil as rules don't hold any value
+func (f *ruleField) Value() interface{} {
+	return nil
+}
+
+// Description returns the rule description
+func (f *ruleField) Description() string {
+	return f.description
+}
+
+// SetDescription sets the rule description
+func (f *ruleField) SetDescription(description string) {
+	f.description = description
+}
+
+// Assign does nothing as rules don't read the input data
+func (f *ruleField) Assign(data map[string]interface{}, schema *Schema) error {
+	return nil
+}
+
+// Validate runs the rule against the schema.
+// Rules may return Errors to attach errors to several fields.
+func (f *ruleField) Validate(schema *Schema) error {
+	return f.check(schema)
+}
+
+// isRule reports whether a field is a schema rule rather than a data field
+func isRule(field Field) bool {
+	_, ok := field.(*ruleField)
+	return ok
+}
+
+// validationOrder returns the fields in declaration order, the schema validators last
+func (s *Schema) validationOrder() []Field {
+	var last []Field
+	for _, field := range s.fields {
+		if rule, ok := field.(*ruleField); ok && rule.last {
+			last = append(last, field)
+		}
+	}
+	if len(last) == 0 {
+		return s.fields
+	}
+
+	order := make([]Field, 0, len(s.fields))
+	for _, field := range s.fields {
+		if rule, ok := field.(*ruleField); !ok || !rule.last {
+			order = append(order, field)
+		}
+	}
+
+	return append(order, last...)
+}
+
+// Invariant creates a schema rule running fn once every field is validated, for the invariants
+// of the whole payload. fn reads the fields with GetFieldValue; the errors it returns are attached to the
+// schema (with an empty field name and path), or to fields when it returns Errors.
+//
+//	poxxy.Invariant(func(s *poxxy.Schema) error {
+//		if len(items) == 0 && giftCard == "" {
+//			return errors.New("order must contain items or a gift card")
+//		}
+//		return nil
+//	})
+func Invariant(fn func(schema *Schema) error) Field {
+	return &ruleField{check: fn, last: true}
+}
+
+// DateRangeOption holds the configuration of a DateRange rule
+type DateRangeOption struct {
+	maxSpan time.Duration
+}
+
+// Apply applies the option to a date range rule
+func (o DateRangeOption) Apply(field interface{}) {
+	if f, ok := field.(*dateRange); ok {
+		f.maxSpan = o.maxSpan
+	} else {
+		panic(fmt.Sprintf("MaxSpan doesn't support %T", field))
+	}
+}
+
+// MaxSpan limits the duration between the start and the end of a DateRange
+func MaxSpan(span time.Duration) Option {
+	return DateRangeOption{maxSpan: span}
+}
+
+// dateRange holds the configuration of a DateRange rule
+type dateRange struct {
+	maxSpan time.Duration
+}
+
+// DateRange creates a schema rule validating that the end time field is not before the start time field
+// and, with MaxSpan, that the range doesn't exceed the given duration.
+// Both fields must be time.Time or *time.Time fields of the same schema; errors are attached to the end field.
+// The rule is skipped when one of the fields is unassigned (use Required() to enforce presence).
+//
+//	poxxy.NewSchema(
+//		poxxy.Value("start", &start),
+//		poxxy.Value("end", &end),
+//		poxxy.DateRange("start", "end", poxxy.MaxSpan(31*24*time.Hour)),
+//	)
+func DateRange(startField, endField string, opts ...Option) Field {
+	config := &dateRange{}
+	for _, opt := range opts {
+		opt.Apply(config)
+	}
+
+	return &ruleField{
+		name: endField,
+		check: func(schema *Schema) error {
+			start, ok := timeFieldValue(schema, startField)
+			if !ok {
+				return nil
+			}
+
+			end, ok := timeFieldValue(schema, endField)
+			if !ok {
+				return nil
+			}
+
+			if end.Before(start) {
+				return newValidationError(CodeDateOrder, "start", startField)
+			}
+
+			if config.maxSpan > 0 && end.Sub(start) > config.maxSpan {
+				return newValidationError(CodeDateSpan, "max", config.maxSpan.String())
+			}
+
+			return nil
+		},
+	}
+}
+
+// RequiredTogether creates a schema rule requiring the given fields to be provided together:
+// either none or all of them must be present in the input (e.g. "lat" and "lng", "page" and "per_page").
+// Each missing field gets its own error when only some of them are provided.
+func RequiredTogether(fieldNames ...string) Field {
+	return &ruleField{
+		name: strings.Join(fieldNames, ","),
+		check: func(schema *Schema) error {
+			var provided, missing []string
+			for _, name := range fieldNames {
+				if isProvided(schema, name) {
+					provided = append(provided, name)
+				} else {
+					missing = append(missing, name)
+				}
+			}
+
+			if len(provided) == 0 || len(missing) == 0 {
+				return nil
+			}
+
+			var errs Errors
+			for _, name := range missing {
+				errs = append(errs, FieldError{
+					Field: name,
+					Error: newValidationError(CodeRequiredTogether, "fields", strings.Join(provided, ", ")),
+				})
+			}
+
+			return errs
+		},
+	}
+}
+
+// requiredWithValidator requires its field when one of the other fields is provided
+type requiredWithValidator struct {
+	fieldNames []string
+	msg        string
+}
+
+// RequiredWith returns the field-level form of RequiredTogether: the field is required when one of the given
+// fields is provided. Declared on each field of the group, it reports the same errors as RequiredTogether:
+//
+//	poxxy.Value("lat", &lat, poxxy.WithValidators(poxxy.RequiredWith("lng"))),
+//	poxxy.Value("lng", &lng, poxxy.WithValidators(poxxy.RequiredWith("lat"))),
+func RequiredWith(fieldNames ...string) Validator {
+	return &requiredWithValidator{fieldNames: fieldNames}
+}
+
+// Validate returns an error: the other fields are read from the schema, the validator must be declared on a field
+func (v *requiredWithValidator) Validate(value interface{}, fieldName string) error {
+	return fmt.Errorf("required with validation of %s requires a schema", fieldName)
+}
+
+// validateWithSchema requires the field when one of the other fields is provided
+func (v *requiredWithValidator) validateWithSchema(schema *Schema, value interface{}, fieldName string) error {
+	if isProvided(schema, fieldName) {
+		return nil
+	}
+
+	var provided []string
+	for _, name := range v.fieldNames {
+		if isProvided(schema, name) {
+			provided = append(provided, name)
+		}
+	}
+	if len(provided) == 0 {
+		return nil
+	}
+
+	if v.msg != "" {
+		return &ValidationError{Code: CodeRequiredTogether, Message: v.msg}
+	}
+
+	return newValidationError(CodeRequiredTogether, "fields", strings.Join(provided, ", "))
+}
+
+// WithMessage sets a custom error message for the validator
+func (v *requiredWithValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the validator
+func (v *requiredWithValidator) Describe() ConstraintInfo {
+	return ConstraintInfo{Kind: "required_with", Params: map[string]interface{}{"fields": v.fieldNames}}
+}
+
+// AtMostOneOf creates a schema rule allowing at most one of the given fields to be provided
+// (e.g. "coupon_code" and "gift_card"). When several are provided, each of them gets an error
+// listing the other conflicting fields found in the input.
+func AtMostOneOf(fieldNames ...string) Field {
+	return &ruleField{
+		name: strings.Join(fieldNames, ","),
+		check: func(schema *Schema) error {
+			var provided []string
+			for _, name := range fieldNames {
+				if isProvided(schema, name) {
+					provided = append(provided, name)
+				}
+			}
+
+			if len(provided) <= 1 {
+				return nil
+			}
+
+			var errs Errors
+			for i, name := range provided {
+				others := make([]string, 0, len(provided)-1)
+				others = append(others, provided[:i]...)
+				others = append(others, provided[i+1:]...)
+
+				errs = append(errs, FieldError{
+					Field: name,
+					Error: newValidationError(CodeAtMostOneOf, "fields", strings.Join(others, ", ")),
+				})
+			}
+
+			return errs
+		},
+	}
+}
+
+// isProvided reports whether a field has a non-empty value in the input data
+func isProvided(schema *Schema, fieldName string) bool {
+	value, exists := schema.data[fieldName]
+	return exists && !isEmpty(value)
+}
+
+// timeFieldValue returns the time assigned to a time.Time or *time.Time field
+func timeFieldValue(schema *Schema, fieldName string) (time.Time, bool) {
+	value, ok := schema.GetFieldValue(fieldName)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// RequiredIf creates a schema rule requiring a field to be provided when condition holds,
+// e.g. RequiredIf("vat_number", FieldEquals("type", "company")). The error is attached to the field.
+func RequiredIf(fieldName string, condition Condition) Field {
+	return &ruleField{
+		name: fieldName,
+		check: func(schema *Schema) error {
+			if condition(schema) && !isProvided(schema, fieldName) {
+				return errRequired
+			}
+
+			return nil
+		},
+	}
+}
+
+// RequiredUnless creates a schema rule requiring a field to be provided unless condition holds,
+// e.g. a phone number required when no email is given: RequiredUnless("phone", FieldPresent("email")).
+func RequiredUnless(fieldName string, condition Condition) Field {
+	return RequiredIf(fieldName, Not(condition))
+}
+
+// EqualToField creates a schema rule validating that a field has the same value as another field,
+// e.g. EqualToField("password_confirmation", "password"). Pointer fields are compared by the value
+// they point to; the error is attached to the first field. The rule is skipped when the field is not provided
+// (use Required() to enforce presence).
+func EqualToField(fieldName, otherField string) Field {
+	return &ruleField{
+		name: fieldName,
+		check: func(schema *Schema) error {
+			if !isProvided(schema, fieldName) {
+				return nil
+			}
+
+			value, _ := conditionValue(schema, fieldName)
+			other, _ := conditionValue(schema, otherField)
+			if !reflect.DeepEqual(value, other) {
+				return newValidationError(CodeEqualToField, "field", otherField)
+			}
+
+			return nil
+		},
+	}
+}
+
+// CrossField creates a schema rule validating several fields together with a custom function.
+// check receives the values assigned to fieldNames, by name (pointer fields are dereferenced, unassigned
+// fields are missing from the map); its error is attached to the field named fieldName.
+// check may return Errors to attach errors to other fields.
+//
+//	poxxy.CrossField("end_date", []string{"start_date", "end_date"}, func(values map[string]interface{}) error {
+//		start, _ := values["start_date"].(time.Time)
+//		end, _ := values["end_date"].(time.Time)
+//		if !end.After(start) {
+//			return errors.New("must be after start_date")
+//		}
+//		return nil
+//	})
+func CrossField(fieldName string, fieldNames []string, check func(values map[string]interface{}) error) Field {
+	return &ruleField{
+		name: fieldName,
+		check: func(schema *Schema) error {
+			values := make(map[string]interface{}, len(fieldNames))
+			for _, name := range fieldNames {
+				if value, ok := conditionValue(schema, name); ok {
+					values[name] = value
+				}
+			}
+
+			return check(values)
+		},
+	}
+}
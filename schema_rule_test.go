@@ -0,0 +1,268 @@
+package poxxy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateRange(t *testing.T) {
+	var start, end time.Time
+
+	newSchema := func() *Schema {
+		return NewSchema(
+			Value("start", &start),
+			Value("end", &end),
+			DateRange("start", "end", MaxSpan(31*24*time.Hour)),
+		)
+	}
+
+	t.Run("valid range", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"start": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			"end":   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"start": time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			"end":   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+		require.Error(t, err)
+
+		errs := err.(Errors)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "end", errs[0].Field)
+		assert.EqualError(t, errs[0].Error, "must not be before start")
+	})
+
+	t.Run("span too long", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"start": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			"end":   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "end: range must not exceed 744h0m0s")
+	})
+
+	t.Run("skipped when a bound is missing", func(t *testing.T) {
+		err := newSchema().Apply(map[string]interface{}{
+			"start": time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		var from, to *time.Time
+		schema := NewSchema(
+			Pointer("from", &from),
+			Pointer("to", &to),
+			DateRange("from", "to"),
+		)
+
+		err := schema.Apply(map[string]interface{}{
+			"from": time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			"to":   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("rules are not listed as fields", func(t *testing.T) {
+		schema := newSchema()
+		assert.Len(t, schema.Describe(), 2)
+		assert.Len(t, schema.ToMap(), 2)
+	})
+}
+
+func TestRequiredTogether(t *testing.T) {
+	var lat, lng float64
+	var zoom int
+
+	schema := NewSchema(
+		Value("lat", &lat),
+		Value("lng", &lng),
+		Value("zoom", &zoom),
+		RequiredTogether("lat", "lng"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"zoom": 3}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"lat": 48.85, "lng": 2.35}))
+
+	err := schema.Apply(map[string]interface{}{"lat": 48.85})
+	require.Error(t, err)
+	assert.Equal(t, Errors{{Field: "lng", Error: newValidationError(CodeRequiredTogether, "fields", "lat"), Path: Path{"lng"}, Code: CodeRequiredTogether}}, err)
+	assert.EqualError(t, err, "lng: required together with lat")
+
+	t.Run("reports every missing field", func(t *testing.T) {
+		var page, perPage int
+		var sort string
+		schema := NewSchema(
+			Value("page", &page),
+			Value("per_page", &perPage),
+			Value("sort", &sort),
+			RequiredTogether("page", "per_page", "sort"),
+		)
+
+		err := schema.Apply(map[string]interface{}{"page": 2, "sort": ""})
+		assert.EqualError(t, err, "per_page: required together with page; sort: required together with page")
+	})
+}
+
+func TestRequiredWith(t *testing.T) {
+	var lat, lng *float64
+	var zoom int
+
+	newSchema := func() *Schema {
+		return NewSchema(
+			Pointer("lat", &lat, WithValidators(RequiredWith("lng"))),
+			Pointer("lng", &lng, WithValidators(RequiredWith("lat"))),
+			Value("zoom", &zoom, WithValidators(RequiredWith("lat", "lng").WithMessage("zoom needs coordinates"))),
+		)
+	}
+
+	assert.NoError(t, newSchema().Apply(map[string]interface{}{}))
+	assert.NoError(t, newSchema().Apply(map[string]interface{}{"lat": 48.85, "lng": 2.35, "zoom": 3}))
+
+	err := newSchema().Apply(map[string]interface{}{"lat": 48.85, "zoom": 3})
+	assert.EqualError(t, err, "lng: required together with lat")
+	assert.Equal(t, CodeRequiredTogether, err.(Errors)[0].Code)
+
+	err = newSchema().Apply(map[string]interface{}{"lat": 48.85, "lng": 2.35})
+	assert.EqualError(t, err, "zoom: zoom needs coordinates")
+
+	assert.Equal(t, ConstraintInfo{Kind: "required_with", Params: map[string]interface{}{"fields": []string{"lat"}}}, RequiredWith("lat").(Describer).Describe())
+}
+
+func TestAtMostOneOf(t *testing.T) {
+	var coupon, giftCard, voucher string
+
+	schema := NewSchema(
+		Value("coupon_code", &coupon),
+		Value("gift_card", &giftCard),
+		Value("voucher", &voucher),
+		AtMostOneOf("coupon_code", "gift_card", "voucher"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"gift_card": "GC-1", "coupon_code": ""}))
+
+	err := schema.Apply(map[string]interface{}{"coupon_code": "WELCOME", "gift_card": "GC-1"})
+	assert.EqualError(t, err, "coupon_code: conflicts with gift_card; gift_card: conflicts with coupon_code")
+
+	err = schema.Apply(map[string]interface{}{"coupon_code": "WELCOME", "gift_card": "GC-1", "voucher": "V"})
+	require.Error(t, err)
+	assert.Len(t, err.(Errors), 3)
+	assert.Equal(t, "voucher", err.(Errors)[2].Field)
+	assert.EqualError(t, err.(Errors)[2].Error, "conflicts with coupon_code, gift_card")
+}
+
+func TestRequiredIf(t *testing.T) {
+	var accountType, vatNumber, email, phone string
+
+	schema := NewSchema(
+		Value("type", &accountType),
+		Value("vat_number", &vatNumber),
+		Value("email", &email),
+		Value("phone", &phone),
+		RequiredIf("vat_number", FieldEquals("type", "company")),
+		RequiredUnless("phone", FieldPresent("email")),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"type": "person", "email": "a@example.com"}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"type": "company", "vat_number": "FR40303265045", "phone": "0102030405"}))
+
+	err := schema.Apply(map[string]interface{}{"type": "company", "vat_number": "", "email": ""})
+	require.Error(t, err)
+	assert.EqualError(t, err, "vat_number: field is required; phone: field is required")
+	assert.Equal(t, CodeRequired, err.(Errors)[0].Code)
+}
+
+func TestEqualToField(t *testing.T) {
+	var password, confirmation string
+	var pin, pinConfirmation *int
+
+	schema := NewSchema(
+		Value("password", &password),
+		Value("password_confirmation", &confirmation),
+		Pointer("pin", &pin),
+		Pointer("pin_confirmation", &pinConfirmation),
+		EqualToField("password_confirmation", "password"),
+		EqualToField("pin_confirmation", "pin"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"password": "s3cret"}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"password": "s3cret", "password_confirmation": "s3cret", "pin": 1234, "pin_confirmation": "1234"}))
+
+	err := schema.Apply(map[string]interface{}{"password": "s3cret", "password_confirmation": "secret", "pin_confirmation": 1234})
+	assert.EqualError(t, err, "password_confirmation: must match password; pin_confirmation: must match pin")
+}
+
+func TestCrossField(t *testing.T) {
+	var start, end time.Time
+
+	schema := NewSchema(
+		Value("start_date", &start),
+		Value("end_date", &end),
+		CrossField("end_date", []string{"start_date", "end_date"}, func(values map[string]interface{}) error {
+			start, _ := values["start_date"].(time.Time)
+			end, _ := values["end_date"].(time.Time)
+			if !end.After(start) {
+				return errors.New("must be after start_date")
+			}
+			return nil
+		}),
+	)
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, schema.Apply(map[string]interface{}{"start_date": day, "end_date": day.AddDate(0, 0, 1)}))
+
+	err := schema.Apply(map[string]interface{}{"start_date": day, "end_date": day})
+	assert.EqualError(t, err, "end_date: must be after start_date")
+}
+
+func TestInvariant(t *testing.T) {
+	var validated []string
+	apply := func(data map[string]interface{}) error {
+		var items []string
+		var giftCard string
+		var total int
+
+		schema := NewSchema(
+			Invariant(func(s *Schema) error {
+				validated = append(validated, "schema")
+				if len(items) == 0 && giftCard == "" {
+					return errors.New("order must contain items or a gift card")
+				}
+				if value, _ := s.GetFieldValue("total"); value.(int) > 100 && giftCard != "" {
+					return Errors{{Field: "gift_card", Error: errors.New("cannot be used above 100")}}
+				}
+				return nil
+			}),
+			Slice("items", &items),
+			Value("gift_card", &giftCard, WithValidators(ValidatorFunc(func(value string, fieldName string) error {
+				validated = append(validated, fieldName)
+				return nil
+			}))),
+			Value("total", &total),
+		)
+
+		return schema.Apply(data)
+	}
+
+	assert.NoError(t, apply(map[string]interface{}{"items": []string{"book"}, "total": 20}))
+	assert.Equal(t, []string{"gift_card", "schema"}, validated, "schema validators run last")
+
+	err := apply(map[string]interface{}{"total": 20})
+	require.Error(t, err)
+	assert.EqualError(t, err, "order must contain items or a gift card")
+	assert.Equal(t, "", err.(Errors)[0].Field)
+	assert.Equal(t, Path{}, err.(Errors)[0].Path)
+
+	err = apply(map[string]interface{}{"gift_card": "GC-1", "total": 200})
+	assert.EqualError(t, err, "gift_card: cannot be used above 100")
+}
@@ -2,6 +2,8 @@ package poxxy
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -419,4 +421,513 @@ func TestSchema_ApplyWithMultipleErrors(t *testing.T) {
 	errs, ok := err.(Errors)
 	require.True(t, ok)
 	require.Len(t, errs, 8)
+
+	fields := make([]string, len(errs))
+	for i, fieldError := range errs {
+		fields[i] = fieldError.Field
+	}
+	assert.Equal(t, []string{"page", "limit", "email_address", "periode_type", "abtdated", "abtdatef", "abtedi", "abtreg"}, fields)
+}
+
+func TestSchema_ApplyErrorsOrder(t *testing.T) {
+	var name string
+	var age int
+	var start, end time.Time
+	var scores []int
+
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required())),
+		Value("start", &start),
+		Value("end", &end),
+		Value("age", &age, WithValidators(Min(18))),
+		Slice("scores", &scores, WithValidators(ValidatorFunc(func(v []int, fieldName string) error {
+			return ElementErrors{{Index: 2, Error: fmt.Errorf("too high")}, {Index: 0, Error: fmt.Errorf("too low")}}
+		}))),
+		DateRange("start", "end"),
+	)
+
+	err := schema.Apply(map[string]interface{}{
+		"age":    "abc",
+		"start":  time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		"end":    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"scores": []interface{}{1, 2, 3},
+	})
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	fields := make([]string, len(errs))
+	for i, fieldError := range errs {
+		fields[i] = fieldError.Field
+	}
+
+	// Assignment errors (age) and rule errors (end) are sorted by declaration order,
+	// the assignment error of a field staying before its validation errors
+	assert.Equal(t, []string{"name", "end", "age", "age", "scores"}, fields)
+	assert.Equal(t, ElementErrors{{Index: 0, Error: fmt.Errorf("too low")}, {Index: 2, Error: fmt.Errorf("too high")}}, errs[4].Error)
+}
+
+func TestSchema_ApplySecondaryErrors(t *testing.T) {
+	var age, count int
+	schema := NewSchema(
+		Value("age", &age, WithValidators(Required(), Min(18))),
+		Value("count", &count, WithValidators(Min(1), Min(1))),
+	)
+
+	err := schema.Apply(map[string]interface{}{"age": "abc", "count": 0})
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+
+	// The Required error of age follows from its assignment error
+	assert.Equal(t, "age", errs[0].Field)
+	assert.False(t, errs[0].Secondary)
+	assert.Equal(t, "age", errs[1].Field)
+	assert.True(t, errs[1].Secondary)
+	assert.Equal(t, "field is required", errs[1].Error.Error())
+
+	// The repeated Min error of count is reported once
+	assert.Equal(t, "count", errs[2].Field)
+	assert.False(t, errs[2].Secondary)
+
+	primary := errs.Primary()
+	require.Len(t, primary, 2)
+	assert.Contains(t, primary[0].Error.Error(), "cannot convert string to int")
+	assert.Equal(t, "count", primary[1].Field)
+}
+
+func TestSchema_ApplyWithSkipValidationOnAssignError(t *testing.T) {
+	var age int
+	var start, end time.Time
+	validated := false
+	schema := NewSchema(
+		Value("age", &age, WithValidators(Required(), Min(18), ValidatorFunc(func(v int, fieldName string) error {
+			validated = true
+			return nil
+		}))),
+		Value("start", &start),
+		Value("end", &end),
+		DateRange("start", "end"),
+	)
+
+	data := map[string]interface{}{"age": "abc", "start": time.Now(), "end": "yesterday"}
+
+	err := schema.Apply(data, WithSkipValidationOnAssignError())
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "age", errs[0].Field)
+	assert.Contains(t, errs[0].Error.Error(), "cannot convert string to int")
+	assert.Equal(t, "end", errs[1].Field)
+	assert.Contains(t, errs[1].Error.Error(), `invalid time "yesterday"`)
+	assert.False(t, validated)
+
+	t.Run("valid fields are still validated", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{"age": 12}, WithSkipValidationOnAssignError())
+		poxxyErrs, ok := err.(Errors)
+		require.True(t, ok)
+		require.Len(t, poxxyErrs, 1)
+		assert.Equal(t, "value must be at least 18", poxxyErrs[0].Error.Error())
+	})
+}
+
+func TestErrors_Flatten(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+	type Attachment struct {
+		URL string
+	}
+
+	var people []Person
+	var owner Person
+	var scores map[string]int
+	var attachments map[string]Attachment
+
+	schema := NewSchema(
+		Slice("people", &people, WithSubSchema(func(s *Schema, p *Person) {
+			WithSchema(s, Value("name", &p.Name, WithValidators(Required())))
+		})),
+		Struct("owner", &owner, WithSubSchema(func(s *Schema, p *Person) {
+			WithSchema(s, Value("name", &p.Name, WithValidators(Required())))
+		})),
+		Map("scores", &scores),
+		HTTPMap("attachments", &attachments, WithHTTPMapCallback[string, Attachment](func(s *Schema, a *Attachment) {
+			WithSchema(s, Value("url", &a.URL, WithValidators(URL())))
+		})),
+	)
+
+	err := schema.Apply(map[string]interface{}{
+		"people":              []interface{}{map[string]interface{}{"name": "John"}, map[string]interface{}{}},
+		"owner":               map[string]interface{}{},
+		"scores":              map[string]interface{}{"math": "A"},
+		"attachments[a][url]": "not a url",
+	})
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, Path{"people"}, errs[0].Path)
+	assert.EqualError(t, errs[0].Error, "element 1: name: field is required")
+	assert.Contains(t, errs[2].Error.Error(), `key "math": `)
+
+	flat := errs.Flatten()
+	require.Len(t, flat, 4)
+
+	paths := make([]Path, len(flat))
+	fields := make([]string, len(flat))
+	for i, fieldError := range flat {
+		paths[i] = fieldError.Path
+		fields[i] = fieldError.Field
+	}
+	assert.Equal(t, []Path{{"people", 1, "name"}, {"owner", "name"}, {"scores", "math"}, {"attachments", "a", "url"}}, paths)
+	assert.Equal(t, []string{"people[1].name", "owner.name", "scores.math", "attachments.a.url"}, fields)
+	assert.EqualError(t, flat[0].Error, "field is required")
+
+	t.Run("form names", func(t *testing.T) {
+		formErrors := NewFormErrors(err)
+		assert.True(t, formErrors.Has("people.1.name"))
+		assert.True(t, formErrors.Has("attachments.a.url"))
+	})
+}
+
+func TestErrors_MarshalJSON(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+
+	var age int
+	var people []Person
+	schema := NewSchema(
+		Value("age", &age, WithValidators(Required(), Min(18))),
+		Slice("people", &people, WithSubSchema(func(s *Schema, p *Person) {
+			WithSchema(s, Value("name", &p.Name, WithValidators(Required())))
+		})),
+	)
+
+	err := schema.Apply(map[string]interface{}{"age": "abc", "people": []interface{}{map[string]interface{}{}}})
+	require.Error(t, err)
+
+	body, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+
+	var entries []map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &entries))
+	require.Len(t, entries, 3)
+
+	// The message of conversion errors comes from the conversion library
+	assert.Contains(t, entries[0]["message"], `parsing "abc": invalid syntax`)
+	delete(entries[0], "message")
+
+	expected := `[
+		{"field": "age", "path": ["age"]},
+		{"field": "age", "path": ["age"], "code": "required", "message": "field is required", "secondary": true},
+		{"field": "people[0].name", "path": ["people", 0, "name"], "code": "required", "message": "field is required"}
+	]`
+	actual, marshalErr := json.Marshal(entries)
+	require.NoError(t, marshalErr)
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestFieldError_Code(t *testing.T) {
+	var name, email string
+	var age int
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required().WithMessage("please enter your name"))),
+		Value("age", &age, WithValidators(Min(18).WithMessage("too young"))),
+		Value("email", &email, WithValidators(Email())),
+	)
+
+	err := schema.Apply(map[string]interface{}{"age": 12, "email": "nope"})
+	require.Error(t, err)
+
+	errs := err.(Errors)
+	require.Len(t, errs, 3)
+	assert.Equal(t, CodeRequired, errs[0].Code)
+	assert.Equal(t, "please enter your name", errs[0].Error.Error())
+	assert.Equal(t, CodeMin, errs[1].Code)
+	assert.Equal(t, "too young", errs[1].Error.Error())
+	assert.Equal(t, CodeEmail, errs[2].Code)
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, errs[1].Error, &validationErr)
+	assert.Equal(t, CodeMin, validationErr.Code)
+}
+
+func TestSchema_ApplyContext(t *testing.T) {
+	type tenantKey struct{}
+	type Item struct {
+		SKU string
+	}
+
+	var tenants []string
+	skuAvailable := ValidatorFuncContext(func(ctx context.Context, sku string, fieldName string) error {
+		tenants = append(tenants, ctx.Value(tenantKey{}).(string))
+		if sku == "sold-out" {
+			return fmt.Errorf("%s is not available", sku)
+		}
+		return nil
+	})
+
+	var name string
+	var items []Item
+	schema := NewSchema(
+		Value("name", &name, WithValidators(skuAvailable)),
+		Slice("items", &items, WithSubSchema(func(s *Schema, item *Item) {
+			WithSchema(s, Value("sku", &item.SKU, WithValidators(skuAvailable)))
+		})),
+	)
+	data := map[string]interface{}{"name": "book", "items": []interface{}{map[string]interface{}{"sku": "pen"}}}
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	require.NoError(t, schema.ApplyContext(ctx, data))
+	// Sub-schemas receive the context as well
+	assert.Equal(t, []string{"acme", "acme"}, tenants)
+
+	assert.EqualError(t, schema.ApplyContext(ctx, map[string]interface{}{"name": "sold-out"}), "name: sold-out is not available")
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		assert.ErrorIs(t, schema.ApplyContext(ctx, data), context.Canceled)
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		schema := NewSchema(Value("name", &name, WithValidators(skuAvailable.WithMessage("unavailable"))))
+		assert.EqualError(t, schema.ApplyContext(ctx, map[string]interface{}{"name": "sold-out"}), "name: unavailable")
+	})
+}
+
+func TestSchema_MustApply(t *testing.T) {
+	t.Run("valid data does not panic", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name, WithValidators(Required())))
+
+		assert.NotPanics(t, func() {
+			schema.MustApply(map[string]interface{}{"name": "John"})
+		})
+		assert.Equal(t, "John", name)
+	})
+
+	t.Run("invalid data panics with the validation errors", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name, WithValidators(Required())))
+
+		assert.PanicsWithError(t, "name: field is required", func() {
+			schema.MustApply(map[string]interface{}{})
+		})
+	})
+
+	t.Run("MustApplyJSON", func(t *testing.T) {
+		var age int
+		schema := NewSchema(Value("age", &age, WithValidators(Min(18))))
+
+		assert.NotPanics(t, func() {
+			schema.MustApplyJSON([]byte(`{"age": 30}`))
+		})
+		assert.Equal(t, 30, age)
+
+		assert.PanicsWithError(t, "age: value must be at least 18", func() {
+			schema.MustApplyJSON([]byte(`{"age": 12}`))
+		})
+		assert.Panics(t, func() {
+			schema.MustApplyJSON([]byte(`{invalid`))
+		})
+	})
+}
+
+func TestWithDisallowUnknownFields(t *testing.T) {
+	type Address struct {
+		Street string
+	}
+
+	var email string
+	var address Address
+	var filter Filter
+
+	schema := NewSchema(
+		Value("email", &email),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("street", &a.Street))
+		})),
+		Filters("filter", &filter, Filterable[string]("status")),
+		Renamed("mail", "email"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{
+		"mail":           "john@example.com",
+		"address":        map[string]interface{}{"street": "1 rue de Rivoli"},
+		"filter[status]": "active",
+	}, WithDisallowUnknownFields()))
+
+	err := schema.Apply(map[string]interface{}{
+		"emial":   "john@example.com",
+		"address": map[string]interface{}{"stret": "1 rue de Rivoli"},
+		"admin":   true,
+	}, WithDisallowUnknownFields())
+	require.Error(t, err)
+	assert.EqualError(t, err, "address: stret: unknown field; admin: unknown field; emial: unknown field")
+	assert.Equal(t, CodeUnknownField, err.(Errors)[1].Code)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"admin": true}))
+}
+
+func TestApplyJSONArray(t *testing.T) {
+	type Item struct {
+		Name     string
+		Quantity int
+	}
+
+	callback := func(s *Schema, item *Item) {
+		WithSchema(s, Value("name", &item.Name, WithValidators(Required())))
+		WithSchema(s, Value("quantity", &item.Quantity, WithValidators(Min(1))))
+	}
+
+	var items []Item
+	require.NoError(t, ApplyJSONArray([]byte(`[{"name": "book", "quantity": 2}, {"name": "pen", "quantity": 1}]`), &items, callback))
+	assert.Equal(t, []Item{{Name: "book", Quantity: 2}, {Name: "pen", Quantity: 1}}, items)
+
+	err := ApplyJSONArray([]byte(`[{"name": "book", "quantity": 2}, {"quantity": 0}]`), &items, callback)
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	assert.EqualError(t, err, "element 1: name: field is required; quantity: value must be at least 1")
+	flat := errs.Flatten()
+	require.Len(t, flat, 2)
+	assert.Equal(t, "[1].name", flat[0].Field)
+	assert.Equal(t, Path{1, "quantity"}, flat[1].Path)
+
+	var ids []int
+	require.NoError(t, ApplyJSONArray([]byte(`[1, 2, 3]`), &ids, nil))
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	err = ApplyJSONArray([]byte(`{"name": "book"}`), &items, callback)
+	assert.ErrorContains(t, err, "failed to unmarshal request body")
+}
+
+func TestApplyJSONValue(t *testing.T) {
+	type Event struct {
+		Type string
+		ID   int
+	}
+
+	callback := func(s *Schema, e *Event) {
+		WithSchema(s, Value("type", &e.Type, WithValidators(Required())))
+		WithSchema(s, Value("id", &e.ID))
+	}
+
+	var event Event
+	require.NoError(t, ApplyJSONValue([]byte(`{"type": "invoice.paid", "id": 42}`), &event, callback))
+	assert.Equal(t, Event{Type: "invoice.paid", ID: 42}, event)
+
+	err := ApplyJSONValue([]byte(`{"id": 42}`), &event, callback)
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	assert.EqualError(t, err, "type: field is required")
+	assert.Equal(t, "type", errs.Flatten()[0].Field)
+
+	err = ApplyJSONValue([]byte(`["invoice.paid"]`), &event, callback)
+	assert.EqualError(t, err, "expected object for struct field")
+
+	var count int
+	require.NoError(t, ApplyJSONValue([]byte(`42`), &count, nil))
+	assert.Equal(t, 42, count)
+
+	var token string
+	require.NoError(t, ApplyJSONValue([]byte(`"tok_123"`), &token, nil))
+	assert.Equal(t, "tok_123", token)
+}
+
+func TestSchema_ApplyWithAllErrors(t *testing.T) {
+	type Address struct {
+		Zip string
+	}
+
+	var password string
+	var address Address
+	schema := NewSchema(
+		Value("password", &password, WithValidators(MinLength(12), In("Secret123456"))),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("zip", &a.Zip, WithValidators(MinLength(5), PostalCode("FR"))))
+		})),
+	)
+
+	data := map[string]interface{}{"password": "secret", "address": map[string]interface{}{"zip": "AB"}}
+
+	err := schema.Apply(data)
+	require.Error(t, err)
+	assert.Len(t, err.(Errors).Flatten(), 2, "fail fast by default")
+
+	err = schema.Apply(data, WithAllErrors())
+	require.Error(t, err)
+	flat := err.(Errors).Flatten()
+	require.Len(t, flat, 4)
+	assert.Equal(t, []string{"password", "password", "address.zip", "address.zip"}, []string{
+		flat[0].Field, flat[1].Field, flat[2].Field, flat[3].Field,
+	})
+	assert.Equal(t, CodeMinLength, flat[0].Code)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"password": "Secret123456"}, WithAllErrors()))
+
+	err = schema.Apply(data)
+	require.Error(t, err)
+	assert.Len(t, err.(Errors).Flatten(), 2, "options only hold for the Apply they are given to")
+}
+
+func TestSchema_ApplyWithEnvelope(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	var name string
+	var address Address
+	schema := NewSchema(
+		Value("name", &name, WithValidators(Required())),
+		Struct("address", &address, WithSubSchema(func(s *Schema, a *Address) {
+			WithSchema(s, Value("city", &a.City, WithValidators(Required())))
+		})),
+	)
+
+	data := map[string]interface{}{
+		"data": map[string]interface{}{"name": "John", "address": map[string]interface{}{"city": "Paris"}},
+		"meta": map[string]interface{}{"request_id": "abc"},
+	}
+	require.NoError(t, schema.Apply(data, WithEnvelope("data")))
+	assert.Equal(t, "John", name)
+	assert.Equal(t, "Paris", address.City)
+
+	t.Run("error paths start with the envelope", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{
+			"data": map[string]interface{}{"address": map[string]interface{}{}},
+		}, WithEnvelope("data"))
+		var errs Errors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "name: field is required; address: city: field is required", err.Error())
+
+		var paths []string
+		for _, fieldError := range errs.Flatten() {
+			paths = append(paths, fieldError.Path.String())
+		}
+		assert.ElementsMatch(t, []string{"data.name", "data.address.city"}, paths)
+	})
+
+	t.Run("dotted path", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{
+			"body": map[string]interface{}{"data": map[string]interface{}{"name": "Jane"}},
+		}, WithEnvelope("body.data"))
+		require.NoError(t, err)
+		assert.Equal(t, "Jane", name)
+	})
+
+	t.Run("missing or invalid envelope", func(t *testing.T) {
+		err := schema.Apply(map[string]interface{}{"name": "John"}, WithEnvelope("data"))
+		assert.EqualError(t, err, "data: field is required")
+
+		err = schema.Apply(map[string]interface{}{"data": "John"}, WithEnvelope("data"))
+		assert.EqualError(t, err, "data: expected object, got string")
+	})
+
+	t.Run("envelope only applies to its Apply", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"name": "Jim"}))
+		assert.Equal(t, "Jim", name)
+	})
 }
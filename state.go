@@ -0,0 +1,179 @@
+package poxxy
+
+// FieldState describes the outcome of the assignment of a field during the last Apply.
+//
+// The three possible situations are:
+//   - assigned from the input: Assigned is true, Defaulted is false
+//   - assigned from the default value: Assigned and Defaulted are true
+//   - unassigned: Assigned is false, the bound variable was left untouched
+//     (the key may still be Present, e.g. with a null value)
+type FieldState struct {
+	// Present reports whether the field key was present in the input data
+	Present bool
+	// Assigned reports whether the bound variable was set, from the input or from the default value
+	Assigned bool
+	// Defaulted reports whether the value comes from the default value
+	Defaulted bool
+	// Value is the current value of the field, default values included (nil when unassigned)
+	Value interface{}
+}
+
+// assignStateReporter is implemented by built-in fields to report how they were assigned
+type assignStateReporter interface {
+	assignState() (assigned bool, defaulted bool)
+}
+
+// FieldState returns the assignment state of a field by name.
+// The second return value is false if the schema has no such field.
+func (s *Schema) FieldState(fieldName string) (FieldState, bool) {
+	for _, field := range s.fields {
+		if isRule(field) || field.Name() != fieldName {
+			continue
+		}
+
+		// Fields missing from the input of a partial apply were not touched
+		if s.skipped[field] {
+			return FieldState{}, true
+		}
+
+		_, inInput := s.data[fieldName]
+		state := FieldState{
+			Present: inInput,
+			Value:   field.Value(),
+		}
+
+		if reporter, ok := field.(assignStateReporter); ok {
+			state.Assigned, state.Defaulted = reporter.assignState()
+		} else {
+			// Custom fields: fall back to the value and the presence tracking
+			state.Assigned = state.Value != nil
+			state.Defaulted = state.Assigned && !inInput
+		}
+
+		// Fields reading several input keys (e.g. HTTPMap) are present when assigned from the input
+		if state.Assigned && !state.Defaulted {
+			state.Present = true
+		}
+
+		return state, true
+	}
+
+	return FieldState{}, false
+}
+
+// Provenance tells where the final value of a field came from
+type Provenance uint8
+
+const (
+	// Untouched means the field was not assigned and its bound variable was left untouched
+	Untouched Provenance = iota
+	// FromInput means the value was provided by the input data
+	FromInput
+	// FromDefault means the value is the default value of the field
+	FromDefault
+)
+
+// String returns the name of the provenance
+func (p Provenance) String() string {
+	switch p {
+	case FromInput:
+		return "input"
+	case FromDefault:
+		return "default"
+	default:
+		return "untouched"
+	}
+}
+
+// Provenance returns where the value of the field came from
+func (st FieldState) Provenance() Provenance {
+	switch {
+	case !st.Assigned:
+		return Untouched
+	case st.Defaulted:
+		return FromDefault
+	default:
+		return FromInput
+	}
+}
+
+// Provenance returns where the value of a field came from during the last Apply.
+// Unknown fields are reported as Untouched.
+//
+// This is typically used to only update the database columns the client actually sent:
+//
+//	if schema.Provenance("email") == poxxy.FromInput { ... }
+func (s *Schema) Provenance(fieldName string) Provenance {
+	state, _ := s.FieldState(fieldName)
+	return state.Provenance()
+}
+
+// WasAssigned reports whether a field was assigned from the input during the last Apply, like the fields
+// kept by ToMap with WithOnlyAssigned. Defaulted and untouched fields are not assigned.
+func (s *Schema) WasAssigned(fieldName string) bool {
+	return s.Provenance(fieldName) == FromInput
+}
+
+// AssignedFields returns the names of the fields assigned from the input during the last Apply (see WasAssigned),
+// in declaration order. With ApplyPartial, these are the fields a PATCH request updates, e.g. the columns
+// of a partial UPDATE statement:
+//
+//	for _, name := range schema.AssignedFields() {
+//		update = update.Set(name, values[name])
+//	}
+func (s *Schema) AssignedFields() []string {
+	var assigned []string
+	for _, field := range s.fields {
+		if !isRule(field) && s.WasAssigned(field.Name()) {
+			assigned = append(assigned, field.Name())
+		}
+	}
+
+	return assigned
+}
+
+// toMapConfig holds the configuration of ToMap
+type toMapConfig struct {
+	onlyAssigned bool
+}
+
+// ToMapOption represents a configuration option for ToMap
+type ToMapOption func(*toMapConfig)
+
+// WithOnlyAssigned makes ToMap only include the fields provided by the client (FromInput),
+// leaving out defaulted and untouched fields
+func WithOnlyAssigned() ToMapOption {
+	return func(c *toMapConfig) {
+		c.onlyAssigned = true
+	}
+}
+
+// ToMap returns the values of the schema fields after the last Apply (transformers included), indexed by field name.
+// Untouched fields are mapped to nil unless WithOnlyAssigned is used.
+//
+// With WithOnlyAssigned, the result is ready to feed an UPDATE statement builder:
+//
+//	changes := schema.ToMap(poxxy.WithOnlyAssigned())
+//	query := squirrel.Update("users").SetMap(changes).Where(squirrel.Eq{"id": id})
+func (s *Schema) ToMap(options ...ToMapOption) map[string]interface{} {
+	config := &toMapConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	result := make(map[string]interface{}, len(s.fields))
+	for _, field := range s.fields {
+		if isRule(field) {
+			continue
+		}
+
+		state, _ := s.FieldState(field.Name())
+		if config.onlyAssigned && state.Provenance() != FromInput {
+			continue
+		}
+
+		result[field.Name()] = state.Value
+	}
+
+	return result
+}
@@ -0,0 +1,199 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_FieldState(t *testing.T) {
+	var name string
+	var page int
+	var limit *int
+	var nickname *string
+
+	parseInt := func(s string) (*int, error) {
+		v, err := convertValue[int](s)
+		return &v, err
+	}
+
+	schema := NewSchema(
+		Value("name", &name),
+		Value("page", &page, WithDefault(1)),
+		ConvertPointer("limit", &limit, parseInt, WithDefault(20)),
+		Pointer("nickname", &nickname),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"name": "John", "nickname": nil}))
+
+	t.Run("assigned from input", func(t *testing.T) {
+		state, ok := schema.FieldState("name")
+		require.True(t, ok)
+		assert.Equal(t, FieldState{Present: true, Assigned: true, Value: "John"}, state)
+	})
+
+	t.Run("assigned from default", func(t *testing.T) {
+		state, ok := schema.FieldState("page")
+		require.True(t, ok)
+		assert.Equal(t, FieldState{Assigned: true, Defaulted: true, Value: 1}, state)
+
+		state, ok = schema.FieldState("limit")
+		require.True(t, ok)
+		assert.True(t, state.Assigned)
+		assert.True(t, state.Defaulted)
+		assert.False(t, state.Present)
+		assert.Equal(t, 20, *state.Value.(*int))
+	})
+
+	t.Run("present but unassigned", func(t *testing.T) {
+		state, ok := schema.FieldState("nickname")
+		require.True(t, ok)
+		assert.Equal(t, FieldState{Present: true}, state)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, ok := schema.FieldState("unknown")
+		assert.False(t, ok)
+	})
+
+	t.Run("state is reset between applies", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"page": 3}))
+
+		state, _ := schema.FieldState("name")
+		assert.Equal(t, FieldState{}, state)
+
+		state, _ = schema.FieldState("page")
+		assert.Equal(t, FieldState{Present: true, Assigned: true, Value: 3}, state)
+	})
+}
+
+func TestSchema_Provenance(t *testing.T) {
+	var name, email string
+	var page int
+
+	schema := NewSchema(
+		Value("name", &name),
+		Value("email", &email),
+		Value("page", &page, WithDefault(1)),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"name": "John"}))
+
+	assert.Equal(t, FromInput, schema.Provenance("name"))
+	assert.Equal(t, Untouched, schema.Provenance("email"))
+	assert.Equal(t, FromDefault, schema.Provenance("page"))
+	assert.Equal(t, Untouched, schema.Provenance("unknown"))
+
+	assert.Equal(t, "input", FromInput.String())
+	assert.Equal(t, "default", FromDefault.String())
+	assert.Equal(t, "untouched", Untouched.String())
+}
+
+func TestSchema_AssignedFields(t *testing.T) {
+	var name, email string
+	var nickname *string
+	var page int
+
+	schema := NewSchema(
+		Value("name", &name),
+		Value("email", &email),
+		Pointer("nickname", &nickname),
+		Value("page", &page, WithDefault(1)),
+		RequiredTogether("name", "email"),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"name": "John", "email": "john@example.com", "nickname": nil}))
+
+	assert.Equal(t, []string{"name", "email"}, schema.AssignedFields())
+	assert.True(t, schema.WasAssigned("email"))
+	assert.False(t, schema.WasAssigned("nickname"))
+	assert.False(t, schema.WasAssigned("page"), "defaulted fields are not assigned from the input")
+	assert.False(t, schema.WasAssigned("unknown"))
+
+	require.NoError(t, schema.Apply(map[string]interface{}{}))
+	assert.Empty(t, schema.AssignedFields())
+}
+
+func TestSchema_ToMap(t *testing.T) {
+	var name, email string
+	var age int
+	var page int
+
+	schema := NewSchema(
+		Value("name", &name, WithTransformers(TrimSpace())),
+		Value("email", &email),
+		Value("age", &age),
+		Value("page", &page, WithDefault(1)),
+	)
+
+	require.NoError(t, schema.Apply(map[string]interface{}{"name": "  John ", "age": 30}))
+
+	t.Run("all fields", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{
+			"name":  "John",
+			"email": nil,
+			"age":   30,
+			"page":  1,
+		}, schema.ToMap())
+	})
+
+	t.Run("only assigned fields", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{
+			"name": "John",
+			"age":  30,
+		}, schema.ToMap(WithOnlyAssigned()))
+	})
+}
+
+func TestSchema_ApplyPartial(t *testing.T) {
+	type User struct {
+		Name     string
+		Email    string
+		Role     string
+		Nickname *string
+	}
+
+	newSchema := func(user *User) *Schema {
+		return NewSchema(
+			Value("name", &user.Name, WithValidators(Required())),
+			Value("email", &user.Email, WithValidators(Required(), Email())),
+			Value("role", &user.Role, WithDefault("member")),
+			Pointer("nickname", &user.Nickname),
+		)
+	}
+
+	user := User{Name: "John", Email: "john@example.com", Role: "admin"}
+	schema := newSchema(&user)
+
+	require.NoError(t, schema.ApplyPartial(map[string]interface{}{"email": "john.doe@example.com"}))
+	assert.Equal(t, User{Name: "John", Email: "john.doe@example.com", Role: "admin"}, user)
+	assert.Equal(t, []string{"email"}, schema.AssignedFields())
+	assert.Equal(t, map[string]interface{}{"email": "john.doe@example.com"}, schema.ToMap(WithOnlyAssigned()))
+	assert.Equal(t, Untouched, schema.Provenance("role"))
+
+	t.Run("null clears a pointer", func(t *testing.T) {
+		nickname := "Johnny"
+		user := User{Name: "John", Email: "john@example.com", Role: "admin", Nickname: &nickname}
+		schema := newSchema(&user)
+
+		require.NoError(t, schema.ApplyPartial(map[string]interface{}{"nickname": nil}))
+		assert.Nil(t, user.Nickname)
+		assert.Equal(t, "John", user.Name)
+		assert.Equal(t, []string{"nickname"}, schema.AssignedFields())
+		assert.True(t, schema.WasAssigned("nickname"))
+		assert.Equal(t, map[string]interface{}{"nickname": (*string)(nil)}, schema.ToMap(WithOnlyAssigned()))
+	})
+
+	err := schema.ApplyPartial(map[string]interface{}{"name": "", "email": "not an email"})
+	assert.EqualError(t, err, "name: field is required; email: invalid email format")
+
+	t.Run("full apply", func(t *testing.T) {
+		var created User
+		schema := newSchema(&created)
+		err := schema.Apply(map[string]interface{}{"name": "Jane"})
+		assert.EqualError(t, err, "email: field is required")
+		assert.Equal(t, "member", created.Role)
+		assert.Equal(t, []string{"name"}, schema.AssignedFields())
+	})
+}
@@ -0,0 +1,38 @@
+package poxxy
+
+import "sync/atomic"
+
+// Statistics holds process-wide counters of the work performed by poxxy.
+// Counters only grow; compare two snapshots (see Sub) to measure a piece of work.
+type Statistics struct {
+	// Applies is the number of Apply calls, including the sub-schemas of slice, map and struct elements
+	Applies uint64
+	// Conversions is the number of values converted to another type (e.g. "42" to 42)
+	Conversions uint64
+	// FieldErrors is the number of field errors returned by Apply
+	FieldErrors uint64
+}
+
+var (
+	statApplies     atomic.Uint64
+	statConversions atomic.Uint64
+	statFieldErrors atomic.Uint64
+)
+
+// Stats returns a snapshot of the counters
+func Stats() Statistics {
+	return Statistics{
+		Applies:     statApplies.Load(),
+		Conversions: statConversions.Load(),
+		FieldErrors: statFieldErrors.Load(),
+	}
+}
+
+// Sub returns the counters accumulated since an earlier snapshot
+func (s Statistics) Sub(earlier Statistics) Statistics {
+	return Statistics{
+		Applies:     s.Applies - earlier.Applies,
+		Conversions: s.Conversions - earlier.Conversions,
+		FieldErrors: s.FieldErrors - earlier.FieldErrors,
+	}
+}
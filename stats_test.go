@@ -0,0 +1,27 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats(t *testing.T) {
+	var age int
+	var name string
+	schema := NewSchema(
+		Value("age", &age),
+		Value("name", &name, WithValidators(Required())),
+	)
+
+	before := Stats()
+	require.NoError(t, schema.Apply(map[string]interface{}{"age": "42", "name": "John"}))
+	assert.Error(t, schema.Apply(map[string]interface{}{"age": 42}))
+	stats := Stats().Sub(before)
+
+	// Counters are process-wide, parallel tests may add to them
+	assert.GreaterOrEqual(t, stats.Applies, uint64(2))
+	assert.GreaterOrEqual(t, stats.Conversions, uint64(1))
+	assert.GreaterOrEqual(t, stats.FieldErrors, uint64(1))
+}
@@ -0,0 +1,53 @@
+package poxxy
+
+import "time"
+
+// FieldTiming holds the time spent on a field during Apply
+type FieldTiming struct {
+	Field string
+	// Assign is the time spent converting and transforming the input value
+	Assign time.Duration
+	// Validate is the time spent running the validators, including context-aware ones (e.g. database lookups)
+	Validate time.Duration
+}
+
+// Total returns the total time spent on the field
+func (t FieldTiming) Total() time.Duration {
+	return t.Assign + t.Validate
+}
+
+// WithTimings creates a schema option recording the time spent assigning and validating each field,
+// available through Timings after Apply.
+func WithTimings() SchemaOption {
+	return func(s *Schema) {
+		s.timingsEnabled = true
+	}
+}
+
+// Timings returns the time spent on each field during the last Apply, in declaration order.
+// It requires the WithTimings option.
+func (s *Schema) Timings() []FieldTiming {
+	return s.timings
+}
+
+// startTimings prepares the timings of an Apply when enabled.
+// It returns the index of each field in the timings, or nil if timings are disabled.
+func (s *Schema) startTimings() map[Field]int {
+	s.timings = nil
+	if !s.timingsEnabled {
+		return nil
+	}
+
+	indexes := make(map[Field]int, len(s.fields))
+	s.timings = make([]FieldTiming, 0, len(s.fields))
+	for _, field := range s.fields {
+		if isRule(field) {
+			continue
+		}
+
+		indexes[field] = len(s.timings)
+		s.timings = append(s.timings, FieldTiming{Field: field.Name()})
+	}
+
+	return indexes
+}
@@ -0,0 +1,39 @@
+package poxxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_Timings(t *testing.T) {
+	var name, email string
+	slowLookup := func(ctx context.Context, value string) (bool, error) {
+		time.Sleep(20 * time.Millisecond)
+		return false, nil
+	}
+
+	schema := NewSchema(
+		Value("name", &name),
+		Value("email", &email, WithValidators(NotExistsIn(slowLookup))),
+	)
+
+	t.Run("disabled by default", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"name": "John", "email": "john@example.com"}))
+		assert.Nil(t, schema.Timings())
+	})
+
+	t.Run("per field durations", func(t *testing.T) {
+		require.NoError(t, schema.Apply(map[string]interface{}{"name": "John", "email": "john@example.com"}, WithTimings()))
+
+		timings := schema.Timings()
+		require.Len(t, timings, 2)
+		assert.Equal(t, "name", timings[0].Field)
+		assert.Equal(t, "email", timings[1].Field)
+		assert.GreaterOrEqual(t, timings[1].Validate, 20*time.Millisecond)
+		assert.Less(t, timings[0].Total(), timings[1].Total())
+	})
+}
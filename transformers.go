@@ -1,7 +1,9 @@
 package poxxy
 
 import (
+	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	"golang.org/x/text/cases"
@@ -16,7 +18,8 @@ type Transformer[T any] interface {
 
 // TransformerFn is a function that implements Transformer
 type TransformerFn[T any] struct {
-	fn func(T) (T, error)
+	name string
+	fn   func(T) (T, error)
 }
 
 // Transform applies the transformation function to the value
@@ -24,6 +27,40 @@ func (t TransformerFn[T]) Transform(value T) (T, error) {
 	return t.fn(value)
 }
 
+// Name returns the name of the transformer (e.g. "trim_space"), used in normalization reports
+func (t TransformerFn[T]) Name() string {
+	if t.name == "" {
+		return "custom"
+	}
+
+	return t.name
+}
+
+// applyTransformers applies the transformers in order to the value of a field,
+// recording their changes in the normalization report of the schema
+func applyTransformers[T any](transformers []Transformer[T], value T, fieldName string, schema *Schema) (T, error) {
+	for _, transformer := range transformers {
+		transformed, err := transformer.Transform(value)
+		if err != nil {
+			return value, err
+		}
+
+		schema.recordNormalization(fieldName, transformerName(transformer), value, transformed)
+		value = transformed
+	}
+
+	return value, nil
+}
+
+// transformerName returns the name of a transformer, or its type for transformers without a Name method
+func transformerName(transformer interface{}) string {
+	if named, ok := transformer.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+
+	return fmt.Sprintf("%T", transformer)
+}
+
 // TransformerOption holds transformers
 type TransformerOption[T any] struct {
 	transformers []Transformer[T]
@@ -75,6 +112,7 @@ func WithTransformers[T any](transformers ...Transformer[T]) Option {
 // ToUpper transforms a string to uppercase
 func ToUpper() Transformer[string] {
 	return TransformerFn[string]{
+		name: "to_upper",
 		fn: func(value string) (string, error) {
 			return strings.ToUpper(value), nil
 		},
@@ -84,6 +122,7 @@ func ToUpper() Transformer[string] {
 // ToLower transforms a string to lowercase
 func ToLower() Transformer[string] {
 	return TransformerFn[string]{
+		name: "to_lower",
 		fn: func(value string) (string, error) {
 			return strings.ToLower(value), nil
 		},
@@ -93,6 +132,7 @@ func ToLower() Transformer[string] {
 // TrimSpace removes leading and trailing whitespace
 func TrimSpace() Transformer[string] {
 	return TransformerFn[string]{
+		name: "trim_space",
 		fn: func(value string) (string, error) {
 			return strings.TrimSpace(value), nil
 		},
@@ -102,6 +142,7 @@ func TrimSpace() Transformer[string] {
 // TitleCase transforms a string to title case
 func TitleCase() Transformer[string] {
 	return TransformerFn[string]{
+		name: "title_case",
 		fn: func(value string) (string, error) {
 			return cases.Title(language.English).String(value), nil
 		},
@@ -111,6 +152,7 @@ func TitleCase() Transformer[string] {
 // Capitalize transforms a string to capitalize first letter
 func Capitalize() Transformer[string] {
 	return TransformerFn[string]{
+		name: "capitalize",
 		fn: func(value string) (string, error) {
 			if len(value) == 0 {
 				return value, nil
@@ -123,12 +165,23 @@ func Capitalize() Transformer[string] {
 // SanitizeEmail normalizes email addresses
 func SanitizeEmail() Transformer[string] {
 	return TransformerFn[string]{
+		name: "sanitize_email",
 		fn: func(value string) (string, error) {
 			return strings.ToLower(strings.TrimSpace(value)), nil
 		},
 	}
 }
 
+// InLocation converts a time to the given location
+func InLocation(loc *time.Location) Transformer[time.Time] {
+	return TransformerFn[time.Time]{
+		name: "in_location",
+		fn: func(value time.Time) (time.Time, error) {
+			return value.In(loc), nil
+		},
+	}
+}
+
 // CustomTransformer creates a custom transformer from a function
 func CustomTransformer[T any](transform func(T) (T, error)) Transformer[T] {
 	return TransformerFn[T]{fn: transform}
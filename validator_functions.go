@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // RequiredValidator is a special validator that needs access to the schema
@@ -25,22 +27,35 @@ func (v RequiredValidator) WithMessage(msg string) Validator {
 	return RequiredValidator{msg: msg}
 }
 
+// Describe returns the constraint metadata of the validator
+func (v RequiredValidator) Describe() ConstraintInfo {
+	return ConstraintInfo{Kind: "required"}
+}
+
 // ValidateWithSchema validates field presence using schema context
 func (v RequiredValidator) ValidateWithSchema(schema *Schema, fieldName string) error {
 	if !schema.IsFieldPresent(fieldName) {
 		if v.msg != "" {
-			return fmt.Errorf("%s", v.msg)
+			return &ValidationError{Code: CodeRequired, Message: v.msg}
 		}
 
-		return fmt.Errorf("field is required")
+		return errRequired
 	}
 
-	// Additionally, check that the value is not empty
+	// Additionally, check that the value is not empty, a nil value being missing
 	value, _ := schema.GetFieldValue(fieldName)
+	if isNilValue(value) {
+		if v.msg != "" {
+			return &ValidationError{Code: CodeRequired, Message: v.msg}
+		}
+
+		return errRequired
+	}
+
 	validator := NotEmpty()
 	if err := validator.Validate(value, fieldName); err != nil {
 		if v.msg != "" {
-			return fmt.Errorf("%s", v.msg)
+			return withMessage(err, v.msg)
 		}
 
 		return err
@@ -49,16 +64,27 @@ func (v RequiredValidator) ValidateWithSchema(schema *Schema, fieldName string)
 	return nil
 }
 
+// isNilValue reports whether value is nil or a nil pointer
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
 // Required validator - checks if field was present in input data, not if value is non-zero
 func Required() Validator {
 	return RequiredValidator{}
 }
 
-// NotEmpty validator - rejects zero values (use this for non-zero value requirements)
+// NotEmpty validator - rejects zero values (use this for non-zero value requirements).
+// Every empty value, nil included, fails with the not_empty code.
 func NotEmpty() Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "not_empty"}, func(value interface{}, fieldName string) error {
 		if value == nil {
-			return fmt.Errorf("field is required")
+			return newValidationError(CodeNotEmpty)
 		}
 
 		// Handle driver.Valuer
@@ -74,13 +100,23 @@ func NotEmpty() Validator {
 		// Check for zero values
 		v := reflect.ValueOf(value)
 		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			// A nil pointer wrapped in an interface is not caught by the nil check above
+			if v.IsNil() {
+				return newValidationError(CodeNotEmpty)
+			}
+		case reflect.Struct:
+			// Zero structs (e.g. time.Time{}) are considered empty
+			if v.IsZero() {
+				return newValidationError(CodeNotEmpty)
+			}
 		case reflect.String:
 			if v.String() == "" {
-				return fmt.Errorf("value cannot be empty")
+				return newValidationError(CodeNotEmpty)
 			}
 		case reflect.Slice, reflect.Map:
 			if v.Len() == 0 {
-				return fmt.Errorf("value cannot be empty")
+				return newValidationError(CodeNotEmpty)
 			}
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			// We cannot refuse zero values for int types.
@@ -94,10 +130,48 @@ func NotEmpty() Validator {
 	})
 }
 
+// NotBlank validator - rejects strings that are empty or only made of whitespace (e.g. "   "),
+// which pass Required and NotEmpty. Nil values and nil pointers are rejected too.
+func NotBlank() Validator {
+	return newDescribedValidator(ConstraintInfo{Kind: "not_blank"}, func(value interface{}, fieldName string) error {
+		// Handle driver.Valuer
+		if valuer, ok := value.(driver.Valuer); ok {
+			vv, err := valuer.Value()
+			if err != nil {
+				return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+			}
+
+			value = vv
+		}
+
+		if value == nil {
+			return errRequired
+		}
+
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return errRequired
+			}
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.String {
+			return fmt.Errorf("not_blank validation requires string value and not a %T type", value)
+		}
+
+		if strings.TrimSpace(v.String()) == "" {
+			return newValidationError(CodeNotBlank)
+		}
+
+		return nil
+	})
+}
+
 // Email validator validates email format
 func Email() Validator {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "email"}, func(value interface{}, fieldName string) error {
 		// Handle driver.Valuer
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
@@ -124,16 +198,19 @@ func Email() Validator {
 		}
 
 		if !emailRegex.MatchString(str) {
-			return fmt.Errorf("invalid email format")
+			return newValidationError(CodeEmail)
 		}
 
 		return nil
 	})
 }
 
-// Min validator validates that a numeric value is at least the specified minimum
+// Min validator validates that a value is at least the specified minimum.
+// It supports numeric types, time.Time and any type with a `Compare(T) int` method.
 func Min(min interface{}) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	errMin := newBoundError(CodeMin, min)
+
+	return newDescribedValidator(ConstraintInfo{Kind: "min", Params: map[string]interface{}{"min": min}}, func(value interface{}, fieldName string) error {
 		// Handle driver.Valuer
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
@@ -146,6 +223,14 @@ func Min(min interface{}) Validator {
 		v := reflect.ValueOf(value)
 		m := reflect.ValueOf(min)
 
+		// Handle comparable types (e.g. time.Time) through their Compare method
+		if cmp, ok := compareWith(v, m); ok {
+			if cmp < 0 {
+				return errMin
+			}
+			return nil
+		}
+
 		if m.Kind() != v.Kind() {
 			return fmt.Errorf("value must be a %T type", min)
 		}
@@ -154,15 +239,15 @@ func Min(min interface{}) Validator {
 		switch v.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			if v.Int() < m.Convert(v.Type()).Int() {
-				return fmt.Errorf("value must be at least %d", m.Int())
+				return errMin
 			}
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 			if v.Uint() < m.Convert(v.Type()).Uint() {
-				return fmt.Errorf("value must be at least %d", m.Uint())
+				return errMin
 			}
 		case reflect.Float32, reflect.Float64:
 			if v.Float() < m.Convert(v.Type()).Float() {
-				return fmt.Errorf("value must be at least %f", m.Float())
+				return errMin
 			}
 		default:
 			return fmt.Errorf("value must be a numeric type")
@@ -171,9 +256,12 @@ func Min(min interface{}) Validator {
 	})
 }
 
-// Max validator validates that a numeric value is at most the specified maximum
+// Max validator validates that a value is at most the specified maximum.
+// It supports numeric types, time.Time and any type with a `Compare(T) int` method.
 func Max(max interface{}) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	errMax := newBoundError(CodeMax, max)
+
+	return newDescribedValidator(ConstraintInfo{Kind: "max", Params: map[string]interface{}{"max": max}}, func(value interface{}, fieldName string) error {
 		// Handle driver.Valuer
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
@@ -186,6 +274,14 @@ func Max(max interface{}) Validator {
 		v := reflect.ValueOf(value)
 		m := reflect.ValueOf(max)
 
+		// Handle comparable types (e.g. time.Time) through their Compare method
+		if cmp, ok := compareWith(v, m); ok {
+			if cmp > 0 {
+				return errMax
+			}
+			return nil
+		}
+
 		if m.Kind() != v.Kind() {
 			return fmt.Errorf("value must be a %T type and not a %T type", max, value)
 		}
@@ -194,15 +290,15 @@ func Max(max interface{}) Validator {
 		switch v.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			if v.Int() > m.Convert(v.Type()).Int() {
-				return fmt.Errorf("value must be at most %d", m.Int())
+				return errMax
 			}
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 			if v.Uint() > m.Convert(v.Type()).Uint() {
-				return fmt.Errorf("value must be at most %d", m.Uint())
+				return errMax
 			}
 		case reflect.Float32, reflect.Float64:
 			if v.Float() > m.Convert(v.Type()).Float() {
-				return fmt.Errorf("value must be at most %f", m.Float())
+				return errMax
 			}
 		default:
 			return fmt.Errorf("value must be a numeric type")
@@ -211,9 +307,63 @@ func Max(max interface{}) Validator {
 	})
 }
 
+// compareWith compares v to bound using the `Compare(T) int` method of their type (e.g. time.Time.Compare).
+// The second return value is false if the values cannot be compared this way.
+func compareWith(v, bound reflect.Value) (int, bool) {
+	if !v.IsValid() || !bound.IsValid() || v.Type() != bound.Type() {
+		return 0, false
+	}
+
+	method := v.MethodByName("Compare")
+	if !method.IsValid() {
+		return 0, false
+	}
+
+	methodType := method.Type()
+	if methodType.NumIn() != 1 || methodType.NumOut() != 1 || methodType.In(0) != bound.Type() || methodType.Out(0).Kind() != reflect.Int {
+		return 0, false
+	}
+
+	return int(method.Call([]reflect.Value{bound})[0].Int()), true
+}
+
+// newBoundError creates the error of a min or max validator once, at construction.
+// The parameter is named after the code, e.g. {min}.
+func newBoundError(code string, bound interface{}) error {
+	var str string
+	switch m := reflect.ValueOf(bound); m.Kind() {
+	case reflect.Int64:
+		// time.Duration is an int64, but reads better in its own notation
+		if d, ok := bound.(time.Duration); ok {
+			str = d.String()
+		} else {
+			str = strconv.FormatInt(m.Int(), 10)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		str = strconv.FormatInt(m.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		str = strconv.FormatUint(m.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		str = fmt.Sprintf("%f", m.Float())
+	default:
+		str = formatBound(bound)
+	}
+
+	return newValidationError(code, code, str)
+}
+
+// formatBound formats a Min/Max bound for error messages
+func formatBound(bound interface{}) string {
+	if t, ok := bound.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("%v", bound)
+}
+
 // MinLength validator validates that a string or slice has at least the specified length
 func MinLength(minLen int) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "min_length", Params: map[string]interface{}{"min_length": minLen}}, func(value interface{}, fieldName string) error {
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
 			if err != nil {
@@ -226,11 +376,11 @@ func MinLength(minLen int) Validator {
 		switch v.Kind() {
 		case reflect.String:
 			if v.Len() < minLen {
-				return fmt.Errorf("must be at least %d characters long", minLen)
+				return newValidationError(CodeMinLength, "min", strconv.Itoa(minLen))
 			}
 		case reflect.Slice, reflect.Array:
 			if v.Len() < minLen {
-				return fmt.Errorf("must have at least %d items", minLen)
+				return newValidationError(CodeMinItems, "min", strconv.Itoa(minLen))
 			}
 		}
 		return nil
@@ -239,7 +389,7 @@ func MinLength(minLen int) Validator {
 
 // MaxLength validator validates that a string or slice has at most the specified length
 func MaxLength(maxLen int) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "max_length", Params: map[string]interface{}{"max_length": maxLen}}, func(value interface{}, fieldName string) error {
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
 			if err != nil {
@@ -253,11 +403,11 @@ func MaxLength(maxLen int) Validator {
 		switch v.Kind() {
 		case reflect.String:
 			if v.Len() > maxLen {
-				return fmt.Errorf("must be at most %d characters long", maxLen)
+				return newValidationError(CodeMaxLength, "max", strconv.Itoa(maxLen))
 			}
 		case reflect.Slice, reflect.Array:
 			if v.Len() > maxLen {
-				return fmt.Errorf("must have at most %d items", maxLen)
+				return newValidationError(CodeMaxItems, "max", strconv.Itoa(maxLen))
 			}
 		}
 		return nil
@@ -266,7 +416,7 @@ func MaxLength(maxLen int) Validator {
 
 // URL validator validates URL format
 func URL() Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "url"}, func(value interface{}, fieldName string) error {
 		if valuer, ok := value.(driver.Valuer); ok {
 			vv, err := valuer.Value()
 			if err != nil {
@@ -288,11 +438,11 @@ func URL() Validator {
 		}
 
 		if !strings.HasPrefix(str, "http://") && !strings.HasPrefix(str, "https://") {
-			return fmt.Errorf("invalid URL format")
+			return newValidationError(CodeURL)
 		}
 		// Check for domain part after protocol
 		if str == "http://" || str == "https://" {
-			return fmt.Errorf("invalid URL format")
+			return newValidationError(CodeURL)
 		}
 
 		return nil
@@ -306,7 +456,7 @@ func ValidatorFunc[T any](fn func(value T, fieldName string) error) Validator {
 
 // In validator validates that a value is one of the specified values
 func In(values ...interface{}) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "in", Params: map[string]interface{}{"values": values}}, func(value interface{}, fieldName string) error {
 		for _, v := range values {
 			// If value is a driver.Valuer, get the value from it
 			if valuer, ok := value.(driver.Valuer); ok {
@@ -324,13 +474,13 @@ func In(values ...interface{}) Validator {
 			}
 		}
 
-		return fmt.Errorf("value %v must be one of: %v", value, values)
+		return newValidationError(CodeIn, "value", fmt.Sprintf("%v", value), "values", fmt.Sprintf("%v", values))
 	})
 }
 
 // Each validator applies validators to each element of a slice/array
 func Each(validators ...Validator) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "each", Params: map[string]interface{}{"validators": describeValidators(validators)}}, func(value interface{}, fieldName string) error {
 		v := reflect.ValueOf(value)
 		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
 			return fmt.Errorf("Each validator can only be applied to slices or arrays")
@@ -349,9 +499,38 @@ func Each(validators ...Validator) Validator {
 	})
 }
 
+// EachAll validator applies validators to every element of a slice/array and reports all failing elements.
+// Unlike Each, it does not stop at the first failing element: the returned error is an ElementErrors
+// listing each failing element with its index.
+func EachAll(validators ...Validator) Validator {
+	return newDescribedValidator(ConstraintInfo{Kind: "each_all", Params: map[string]interface{}{"validators": describeValidators(validators)}}, func(value interface{}, fieldName string) error {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return fmt.Errorf("EachAll validator can only be applied to slices or arrays")
+		}
+
+		var errs ElementErrors
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i).Interface()
+			for _, validator := range validators {
+				if err := validator.Validate(item, fmt.Sprintf("%s[%d]", fieldName, i)); err != nil {
+					errs = append(errs, ElementError{Index: i, Error: err})
+					break
+				}
+			}
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+
+		return nil
+	})
+}
+
 // Unique validator ensures all elements in slices, arrays, or maps are unique
 func Unique() Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "unique"}, func(value interface{}, fieldName string) error {
 		v := reflect.ValueOf(value)
 
 		switch v.Kind() {
@@ -360,7 +539,7 @@ func Unique() Validator {
 			for i := 0; i < v.Len(); i++ {
 				item := v.Index(i).Interface()
 				if seen[item] {
-					return fmt.Errorf("duplicate value found: %v", item)
+					return newValidationError(CodeUnique, "value", fmt.Sprintf("%v", item))
 				}
 				seen[item] = true
 			}
@@ -372,7 +551,7 @@ func Unique() Validator {
 			for _, key := range v.MapKeys() {
 				mapValue := v.MapIndex(key).Interface()
 				if seen[mapValue] {
-					return fmt.Errorf("duplicate value found: %v", mapValue)
+					return newValidationError(CodeUnique, "value", fmt.Sprintf("%v", mapValue))
 				}
 				seen[mapValue] = true
 			}
@@ -386,7 +565,7 @@ func Unique() Validator {
 
 // UniqueBy validator ensures all elements in slices/arrays are unique by a specific key extractor function
 func UniqueBy(keyExtractor func(interface{}) interface{}) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "unique_by"}, func(value interface{}, fieldName string) error {
 		v := reflect.ValueOf(value)
 
 		switch v.Kind() {
@@ -396,7 +575,7 @@ func UniqueBy(keyExtractor func(interface{}) interface{}) Validator {
 				item := v.Index(i).Interface()
 				key := keyExtractor(item)
 				if seen[key] {
-					return fmt.Errorf("duplicate key found: %v", key)
+					return newValidationError(CodeUniqueBy, "key", fmt.Sprintf("%v", key))
 				}
 				seen[key] = true
 			}
@@ -410,12 +589,12 @@ func UniqueBy(keyExtractor func(interface{}) interface{}) Validator {
 
 // WithMapKeys validator ensures that a map contains all the specified keys
 func WithMapKeys(keys ...string) Validator {
-	return NewInterfaceValidator(func(value interface{}, fieldName string) error {
+	return newDescribedValidator(ConstraintInfo{Kind: "map_keys", Params: map[string]interface{}{"keys": keys}}, func(value interface{}, fieldName string) error {
 		// Try to convert to map[string]string first
 		if mapData, ok := value.(map[string]string); ok {
 			for _, key := range keys {
 				if _, ok := mapData[key]; !ok {
-					return fmt.Errorf("key %v not found in map", key)
+					return newValidationError(CodeMapKeys, "key", key)
 				}
 			}
 
@@ -424,7 +603,7 @@ func WithMapKeys(keys ...string) Validator {
 		if mapData, ok := value.(map[string]interface{}); ok {
 			for _, key := range keys {
 				if _, ok := mapData[key]; !ok {
-					return fmt.Errorf("key %v not found in map", key)
+					return newValidationError(CodeMapKeys, "key", key)
 				}
 			}
 		}
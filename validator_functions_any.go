@@ -0,0 +1,157 @@
+package poxxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValueKind identifies the JSON kind of a dynamic value
+type ValueKind uint8
+
+const (
+	// KindObject is a JSON object (map)
+	KindObject ValueKind = iota + 1
+	// KindArray is a JSON array (slice or array)
+	KindArray
+	// KindString is a JSON string
+	KindString
+	// KindNumber is a JSON number (any Go numeric type or json.Number)
+	KindNumber
+	// KindBool is a JSON boolean
+	KindBool
+)
+
+// String returns the name of the kind
+func (k ValueKind) String() string {
+	switch k {
+	case KindObject:
+		return "object"
+	case KindArray:
+		return "array"
+	case KindString:
+		return "string"
+	case KindNumber:
+		return "number"
+	case KindBool:
+		return "boolean"
+	default:
+		return "unknown"
+	}
+}
+
+// kindOf returns the JSON kind of a value, or 0 if it has none
+func kindOf(value interface{}) ValueKind {
+	if _, ok := value.(json.Number); ok {
+		return KindNumber
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Struct:
+		return KindObject
+	case reflect.Slice, reflect.Array:
+		return KindArray
+	case reflect.String:
+		return KindString
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return KindNumber
+	case reflect.Bool:
+		return KindBool
+	default:
+		return 0
+	}
+}
+
+// OneOfKinds validator validates that a dynamic value is of one of the given JSON kinds
+func OneOfKinds(kinds ...ValueKind) Validator {
+	names := make([]string, len(kinds))
+	values := make([]interface{}, len(kinds))
+	for i, kind := range kinds {
+		names[i] = kind.String()
+		values[i] = kind.String()
+	}
+
+	return newDescribedValidator(ConstraintInfo{Kind: "kinds", Params: map[string]interface{}{"kinds": values}}, func(value interface{}, fieldName string) error {
+		if value == nil {
+			return nil
+		}
+
+		actual := kindOf(value)
+		for _, kind := range kinds {
+			if actual == kind {
+				return nil
+			}
+		}
+
+		return newValidationError(CodeKind, "kind", actual.String(), "kinds", strings.Join(names, ", "))
+	})
+}
+
+// MaxDepth validator validates that a dynamic value doesn't nest objects and arrays deeper than depth.
+// Scalars have a depth of 0, {"a": 1} and [1] a depth of 1.
+func MaxDepth(depth int) Validator {
+	return newDescribedValidator(ConstraintInfo{Kind: "max_depth", Params: map[string]interface{}{"max_depth": depth}}, func(value interface{}, fieldName string) error {
+		if depthOf(reflect.ValueOf(value), depth+1) > depth {
+			return newValidationError(CodeMaxDepth, "max", strconv.Itoa(depth))
+		}
+		return nil
+	})
+}
+
+// depthOf returns the nesting depth of a value, stopping at limit
+func depthOf(v reflect.Value, limit int) int {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+
+	if limit == 0 {
+		return 0
+	}
+
+	max := 0
+	switch v.Kind() {
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if d := depthOf(iter.Value(), limit-1); d > max {
+				max = d
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if d := depthOf(v.Index(i), limit-1); d > max {
+				max = d
+			}
+		}
+	default:
+		return 0
+	}
+
+	return max + 1
+}
+
+// MaxSize validator validates that the JSON encoding of a dynamic value is at most size bytes long
+func MaxSize(size int) Validator {
+	return newDescribedValidator(ConstraintInfo{Kind: "max_size", Params: map[string]interface{}{"max_size": size}}, func(value interface{}, fieldName string) error {
+		if value == nil {
+			return nil
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("cannot encode value: %w", err)
+		}
+
+		if len(encoded) > size {
+			return newValidationError(CodeMaxSize, "max", strconv.Itoa(size))
+		}
+		return nil
+	})
+}
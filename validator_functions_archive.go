@@ -0,0 +1,317 @@
+package poxxy
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ArchiveOption represents a configuration option for Archive
+type ArchiveOption func(*archiveLimits)
+
+// MaxArchiveEntries limits the number of entries (files and directories) of an archive
+func MaxArchiveEntries(n int) ArchiveOption {
+	return func(l *archiveLimits) {
+		l.maxEntries = n
+	}
+}
+
+// MaxUncompressedSize limits the total uncompressed size of the files of an archive, in bytes
+func MaxUncompressedSize(size int64) ArchiveOption {
+	return func(l *archiveLimits) {
+		l.maxSize = size
+	}
+}
+
+// archiveLimits holds the limits checked by Archive
+type archiveLimits struct {
+	maxEntries int
+	maxSize    int64
+}
+
+// archiveEntry describes an entry of an archive
+type archiveEntry struct {
+	name string
+	size int64
+	// link is the target of a symbolic or hard link, relative to the root of the archive
+	link string
+	// symlink is set for symbolic links, target being their target as written in the archive
+	symlink bool
+	target  string
+}
+
+// Archive validator validates an uploaded zip, tar or gzipped tar file (see File) before it is handed to
+// application code: entry names (and link targets) must be relative paths staying inside the extraction
+// directory ("../../etc/passwd" and "/etc/passwd" are rejected), symbolic links must not point to a parent
+// directory and no entry may be stored below a symbolic link, and the entry count and total uncompressed
+// size must not exceed the limits given with MaxArchiveEntries and MaxUncompressedSize.
+//
+// Only the headers are read, and the targets of zip symbolic links. The limits are checked while reading tar
+// headers, so the content of a gzipped tar isn't decompressed past them. The sizes of zip entries are the
+// declared ones; archive/zip refuses to decompress more than the declared size, so they hold for
+// applications extracting with it.
+// The archive is read from UploadedFile.Path: it doesn't support files stored with a FileSink.
+func Archive(opts ...ArchiveOption) Validator {
+	limits := &archiveLimits{}
+	for _, opt := range opts {
+		opt(limits)
+	}
+
+	params := map[string]interface{}{}
+	if limits.maxEntries > 0 {
+		params["max_entries"] = limits.maxEntries
+	}
+	if limits.maxSize > 0 {
+		params["max_uncompressed_size"] = limits.maxSize
+	}
+
+	return newDescribedValidator(ConstraintInfo{Kind: "archive", Params: params}, func(value interface{}, fieldName string) error {
+		var file *UploadedFile
+		switch v := value.(type) {
+		case nil:
+			return nil
+		case UploadedFile:
+			file = &v
+		case *UploadedFile:
+			if v == nil {
+				return nil
+			}
+			file = v
+		default:
+			return fmt.Errorf("archive validation requires an uploaded file and not a %T type", value)
+		}
+
+		if file.Path == "" {
+			return fmt.Errorf("archive validation requires the file on disk, it doesn't support file sinks")
+		}
+
+		entries, err := readArchiveEntries(file.Path, limits)
+		if err != nil {
+			return err
+		}
+
+		return limits.check(entries)
+	})
+}
+
+// check validates the entries of an archive against the limits
+func (l *archiveLimits) check(entries []archiveEntry) error {
+	if err := l.checkCount(len(entries)); err != nil {
+		return err
+	}
+
+	// Chained symbolic links (e.g. "a/l -> ..", then "a/l/x -> ..") escape the extraction directory even when
+	// each of them looks local: paths going through a symbolic link and targets going up are rejected
+	symlinks := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.symlink {
+			symlinks[cleanEntryPath(entry.name)] = true
+		}
+	}
+
+	var size int64
+	for _, entry := range entries {
+		if !isLocalPath(entry.name) {
+			return newValidationError(CodeArchivePath, "path", entry.name)
+		}
+		if entry.link != "" && !isLocalPath(entry.link) {
+			return newValidationError(CodeArchivePath, "path", entry.name)
+		}
+		if entry.symlink && slices.Contains(strings.Split(cleanEntryPath(entry.target), "/"), "..") {
+			return newValidationError(CodeArchivePath, "path", entry.name)
+		}
+		for dir := path.Dir(cleanEntryPath(entry.name)); dir != "."; dir = path.Dir(dir) {
+			if symlinks[dir] {
+				return newValidationError(CodeArchivePath, "path", entry.name)
+			}
+		}
+
+		if err := l.checkSize(&size, entry.size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkCount returns an error if count entries exceed the entry limit
+func (l *archiveLimits) checkCount(count int) error {
+	if l.maxEntries > 0 && count > l.maxEntries {
+		return newValidationError(CodeArchiveEntries, "max", strconv.Itoa(l.maxEntries))
+	}
+
+	return nil
+}
+
+// checkSize adds the size of an entry to the total size, and returns an error if it exceeds the size limit.
+// A negative size is an unknown or overflowing one.
+func (l *archiveLimits) checkSize(total *int64, size int64) error {
+	*total += size
+	if l.maxSize > 0 && (size < 0 || *total < 0 || *total > l.maxSize) {
+		return newValidationError(CodeArchiveSize, "max", strconv.FormatInt(l.maxSize, 10))
+	}
+
+	return nil
+}
+
+// readArchiveEntries reads the entries of a zip, tar or gzipped tar file.
+// The reading of a tar stream stops as soon as it exceeds the limits.
+func readArchiveEntries(name string, limits *archiveLimits) ([]archiveEntry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	magic, _ := r.Peek(4)
+	switch {
+	case strings.HasPrefix(string(magic), "PK\x03\x04"), strings.HasPrefix(string(magic), "PK\x05\x06"):
+		info, err := f.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open archive: %w", err)
+		}
+		return zipEntries(f, info.Size())
+	case strings.HasPrefix(string(magic), "\x1f\x8b"):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, newValidationError(CodeArchive)
+		}
+		defer gz.Close()
+		return tarEntries(gz, limits)
+	default:
+		return tarEntries(r, limits)
+	}
+}
+
+// zipEntries reads the entries of a zip file from its central directory
+func zipEntries(r io.ReaderAt, size int64) ([]archiveEntry, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, newValidationError(CodeArchive)
+	}
+
+	entries := make([]archiveEntry, 0, len(zr.File))
+	for _, f := range zr.File {
+		size := int64(f.UncompressedSize64)
+		if f.UncompressedSize64 > 1<<62 {
+			size = -1
+		}
+
+		entry := archiveEntry{name: f.Name, size: size}
+		if f.Mode()&os.ModeSymlink != 0 {
+			// The target of a zip symbolic link is the content of the entry
+			target, err := zipSymlinkTarget(f)
+			if err != nil {
+				return nil, err
+			}
+			entry.link = symlinkTarget(f.Name, target)
+			entry.symlink, entry.target = true, target
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// maxSymlinkTarget is the length of the longest symbolic link target accepted in a zip file (PATH_MAX)
+const maxSymlinkTarget = 4096
+
+// zipSymlinkTarget reads the target of a zip symbolic link
+func zipSymlinkTarget(f *zip.File) (string, error) {
+	if f.UncompressedSize64 > maxSymlinkTarget {
+		return "", newValidationError(CodeArchivePath, "path", f.Name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return "", newValidationError(CodeArchive)
+	}
+	defer rc.Close()
+
+	target, err := io.ReadAll(io.LimitReader(rc, maxSymlinkTarget))
+	if err != nil {
+		return "", newValidationError(CodeArchive)
+	}
+
+	return string(target), nil
+}
+
+// symlinkTarget returns the target of a symbolic link relative to the root of the archive:
+// relative targets are relative to the directory of the link
+func symlinkTarget(name, target string) string {
+	if isAbsPath(target) {
+		return target
+	}
+
+	return path.Join(path.Dir(strings.ReplaceAll(name, "\\", "/")), strings.ReplaceAll(target, "\\", "/"))
+}
+
+// tarEntries reads the entries of a tar stream, skipping their content. It stops at the first entry
+// exceeding the entry or size limit, before the content of the entry is skipped.
+func tarEntries(r io.Reader, limits *archiveLimits) ([]archiveEntry, error) {
+	var entries []archiveEntry
+	var size int64
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, newValidationError(CodeArchive)
+		}
+
+		entry := archiveEntry{name: header.Name, size: header.Size}
+		switch header.Typeflag {
+		case tar.TypeLink:
+			entry.link = header.Linkname
+		case tar.TypeSymlink:
+			entry.link = symlinkTarget(header.Name, header.Linkname)
+			entry.symlink, entry.target = true, header.Linkname
+		}
+		entries = append(entries, entry)
+
+		if err := limits.checkCount(len(entries)); err != nil {
+			return nil, err
+		}
+		if err := limits.checkSize(&size, header.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, newValidationError(CodeArchive)
+	}
+
+	return entries, nil
+}
+
+// cleanEntryPath returns the cleaned path of an entry name or link target, with forward slashes
+func cleanEntryPath(name string) string {
+	return path.Clean(strings.ReplaceAll(name, "\\", "/"))
+}
+
+// isLocalPath reports whether an entry name is a relative path staying inside the extraction directory
+func isLocalPath(name string) bool {
+	if name == "" || isAbsPath(name) {
+		return false
+	}
+
+	cleaned := cleanEntryPath(name)
+	return cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// isAbsPath reports whether an entry name is an absolute Unix or Windows path
+func isAbsPath(name string) bool {
+	return strings.HasPrefix(name, "/") || strings.HasPrefix(name, "\\") || (len(name) > 1 && name[1] == ':')
+}
@@ -0,0 +1,147 @@
+package poxxy
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	zipArchive := func(t *testing.T, files map[string]string) []byte {
+		var b bytes.Buffer
+		w := zip.NewWriter(&b)
+		for name, content := range files {
+			f, err := w.Create(name)
+			require.NoError(t, err)
+			_, err = f.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+		return b.Bytes()
+	}
+
+	tarArchive := func(t *testing.T, headers ...*tar.Header) []byte {
+		var b bytes.Buffer
+		gz := gzip.NewWriter(&b)
+		w := tar.NewWriter(gz)
+		for _, header := range headers {
+			require.NoError(t, w.WriteHeader(header))
+			_, err := w.Write(make([]byte, header.Size))
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+		require.NoError(t, gz.Close())
+		return b.Bytes()
+	}
+
+	upload := func(t *testing.T, content []byte, validators ...Validator) error {
+		var bundle UploadedFile
+		schema := NewSchema(File("bundle", &bundle, WithValidators(validators...)))
+		err := schema.ApplyMultipart(newMultipartRequest(t, nil, map[string][]byte{"bundle": content}))
+		t.Cleanup(func() { os.Remove(bundle.Path) })
+		return err
+	}
+
+	t.Run("zip", func(t *testing.T) {
+		content := zipArchive(t, map[string]string{"docs/readme.txt": "hello", "docs/license.txt": "MIT"})
+		assert.NoError(t, upload(t, content, Archive(MaxArchiveEntries(2), MaxUncompressedSize(8))))
+
+		err := upload(t, content, Archive(MaxArchiveEntries(1)))
+		assert.EqualError(t, err, "bundle: archive must contain at most 1 entries")
+
+		err = upload(t, content, Archive(MaxUncompressedSize(7)))
+		assert.EqualError(t, err, "bundle: archive must be at most 7 bytes uncompressed")
+
+		err = upload(t, zipArchive(t, map[string]string{"../../etc/passwd": "root"}), Archive())
+		assert.EqualError(t, err, "bundle: archive entry ../../etc/passwd is outside the archive")
+	})
+
+	t.Run("zip symlinks", func(t *testing.T) {
+		symlink := func(t *testing.T, name, target string) []byte {
+			var b bytes.Buffer
+			w := zip.NewWriter(&b)
+			header := &zip.FileHeader{Name: name}
+			header.SetMode(os.ModeSymlink | 0o777)
+			f, err := w.CreateHeader(header)
+			require.NoError(t, err)
+			_, err = f.Write([]byte(target))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			return b.Bytes()
+		}
+
+		assert.NoError(t, upload(t, symlink(t, "app/link", "main.go"), Archive()))
+
+		err := upload(t, symlink(t, "app/link", "../../etc"), Archive())
+		assert.EqualError(t, err, "bundle: archive entry app/link is outside the archive")
+	})
+
+	t.Run("gzipped tar", func(t *testing.T) {
+		content := tarArchive(t,
+			&tar.Header{Name: "app/", Typeflag: tar.TypeDir, Mode: 0o755},
+			&tar.Header{Name: "app/main.go", Typeflag: tar.TypeReg, Mode: 0o644, Size: 100},
+			&tar.Header{Name: "app/link", Typeflag: tar.TypeSymlink, Linkname: "main.go"},
+		)
+		assert.NoError(t, upload(t, content, Archive(MaxArchiveEntries(3), MaxUncompressedSize(100))))
+
+		err := upload(t, content, Archive(MaxUncompressedSize(99)))
+		assert.EqualError(t, err, "bundle: archive must be at most 99 bytes uncompressed")
+
+		// The header of an entry declaring a terabyte is rejected before its content is read
+		var b bytes.Buffer
+		gz := gzip.NewWriter(&b)
+		require.NoError(t, tar.NewWriter(gz).WriteHeader(&tar.Header{Name: "huge.bin", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1 << 40}))
+		require.NoError(t, gz.Close())
+		err = upload(t, b.Bytes(), Archive(MaxUncompressedSize(1<<20)))
+		assert.EqualError(t, err, "bundle: archive must be at most 1048576 bytes uncompressed")
+
+		err = upload(t, tarArchive(t, &tar.Header{Name: "/etc/cron.d/job", Typeflag: tar.TypeReg, Mode: 0o644}), Archive())
+		assert.EqualError(t, err, "bundle: archive entry /etc/cron.d/job is outside the archive")
+
+		err = upload(t, tarArchive(t, &tar.Header{Name: "app/link", Typeflag: tar.TypeSymlink, Linkname: "../../etc"}), Archive())
+		assert.EqualError(t, err, "bundle: archive entry app/link is outside the archive")
+
+		// Chained links escape the extraction directory while each of them stays inside it
+		err = upload(t, tarArchive(t,
+			&tar.Header{Name: "a/", Typeflag: tar.TypeDir, Mode: 0o755},
+			&tar.Header{Name: "a/l", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			&tar.Header{Name: "a/l/x", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			&tar.Header{Name: "a/l/x/evil", Typeflag: tar.TypeReg, Mode: 0o644, Size: 4},
+		), Archive())
+		assert.EqualError(t, err, "bundle: archive entry a/l is outside the archive")
+
+		err = upload(t, tarArchive(t,
+			&tar.Header{Name: "app/evil", Typeflag: tar.TypeReg, Mode: 0o644, Size: 4},
+			&tar.Header{Name: "app", Typeflag: tar.TypeSymlink, Linkname: "static"},
+		), Archive())
+		assert.EqualError(t, err, "bundle: archive entry app/evil is outside the archive")
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		assert.EqualError(t, upload(t, []byte("plain text"), Archive()), "bundle: file is not a valid zip or tar archive")
+		assert.NoError(t, Archive().Validate(nil, "bundle"))
+		assert.EqualError(t, Archive().Validate(UploadedFile{Filename: "bundle.zip"}, "bundle"), "archive validation requires the file on disk, it doesn't support file sinks")
+	})
+}
+
+func TestIsLocalPath(t *testing.T) {
+	for name, local := range map[string]bool{
+		"readme.txt":       true,
+		"docs/../a.txt":    true,
+		"./docs/":          true,
+		"":                 false,
+		"..":               false,
+		"docs/../../a.txt": false,
+		"/etc/passwd":      false,
+		"..\\evil.exe":     false,
+		"C:\\evil.exe":     false,
+	} {
+		assert.Equal(t, local, isLocalPath(name), name)
+	}
+}
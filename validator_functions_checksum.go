@@ -0,0 +1,246 @@
+package poxxy
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+// laPosteSIREN is the SIREN of La Poste, whose establishments mostly don't follow the Luhn checksum
+const laPosteSIREN = "356000000"
+
+// EAN13 validator validates that a string is an EAN-13 barcode number with a valid check digit
+func EAN13() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "ean13"}, func(str string) error {
+		if len(str) != 13 || !isDigits(str) || !ean13Valid(str) {
+			return newValidationError(CodeEAN13)
+		}
+		return nil
+	})
+}
+
+// ISBN validator validates that a string is an ISBN-10 or ISBN-13 with a valid check digit.
+// Hyphens and spaces are ignored, e.g. "978-2-07-036822-8".
+func ISBN() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "isbn"}, func(str string) error {
+		isbn := stripSeparators(str, "- ")
+		switch {
+		case len(isbn) == 13 && isDigits(isbn) && ean13Valid(isbn):
+			return nil
+		case len(isbn) == 10 && isbn10Valid(isbn):
+			return nil
+		default:
+			return newValidationError(CodeISBN)
+		}
+	})
+}
+
+// SIREN validator validates that a string is a French company number (9 digits with a Luhn checksum).
+// Spaces are ignored, e.g. "732 829 320".
+func SIREN() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "siren"}, func(str string) error {
+		siren := stripSeparators(str, " ")
+		if len(siren) != 9 || !isDigits(siren) || !luhnValid(siren) {
+			return newValidationError(CodeSIREN)
+		}
+		return nil
+	})
+}
+
+// SIRET validator validates that a string is a French establishment number (14 digits: the SIREN and a
+// 5-digit establishment number, with a Luhn checksum). Spaces are ignored, e.g. "732 829 320 00074".
+func SIRET() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "siret"}, func(str string) error {
+		siret := stripSeparators(str, " ")
+		if len(siret) != 14 || !isDigits(siret) {
+			return newValidationError(CodeSIRET)
+		}
+
+		// Most La Poste establishments use a sum of digits multiple of 5 instead of the Luhn checksum
+		if strings.HasPrefix(siret, laPosteSIREN) && digitsSum(siret)%5 == 0 {
+			return nil
+		}
+
+		if !luhnValid(siret) {
+			return newValidationError(CodeSIRET)
+		}
+		return nil
+	})
+}
+
+// stripSeparators removes the separator characters from a string
+func stripSeparators(str, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(separators, r) {
+			return -1
+		}
+		return r
+	}, str)
+}
+
+// digitsSum returns the sum of the digits of a digit string
+func digitsSum(digits string) int {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		sum += int(digits[i] - '0')
+	}
+
+	return sum
+}
+
+// ean13Valid reports whether the last digit of a 13-digit string is its EAN-13 check digit
+func ean13Valid(digits string) bool {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d := int(digits[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return (10-sum%10)%10 == int(digits[12]-'0')
+}
+
+// isbn10Valid reports whether a 10-character string is an ISBN-10; the check digit may be X (10)
+func isbn10Valid(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var d int
+		switch c := isbn[i]; {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case (c == 'X' || c == 'x') && i == 9:
+			d = 10
+		default:
+			return false
+		}
+		sum += d * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+// luhnValid reports whether a digit string passes the Luhn checksum
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// ChecksumOption holds the configuration of a ChecksumOf rule
+type ChecksumOption struct {
+	newHash func() hash.Hash
+}
+
+// Apply applies the option to a checksum rule
+func (o ChecksumOption) Apply(field interface{}) {
+	if f, ok := field.(*checksumRule); ok {
+		f.newHash = o.newHash
+	} else {
+		panic(fmt.Sprintf("ChecksumHash doesn't support %T", field))
+	}
+}
+
+// ChecksumHash sets the hash function of a ChecksumOf rule (SHA-256 by default), e.g. ChecksumHash(md5.New)
+func ChecksumHash(newHash func() hash.Hash) Option {
+	return ChecksumOption{newHash: newHash}
+}
+
+// checksumRule holds the configuration of a ChecksumOf rule
+type checksumRule struct {
+	newHash func() hash.Hash
+}
+
+// ChecksumOf creates a schema rule validating that the checksum field holds the SHA-256 (see ChecksumHash)
+// of the content field, for APIs receiving client-provided integrity hashes. The content field may be a
+// string, []byte or UploadedFile field (the file is read from UploadedFile.Path); the checksum is written
+// in hexadecimal or standard base64. Errors are attached to the checksum field; the rule is skipped when
+// one of the fields is not provided.
+//
+//	poxxy.NewSchema(
+//		poxxy.Value("content", &content),
+//		poxxy.Value("content_sha256", &checksum),
+//		poxxy.ChecksumOf("content", "content_sha256"),
+//	)
+func ChecksumOf(contentField, checksumField string, opts ...Option) Field {
+	config := &checksumRule{newHash: sha256.New}
+	for _, opt := range opts {
+		opt.Apply(config)
+	}
+
+	return &ruleField{
+		name: checksumField,
+		check: func(schema *Schema) error {
+			if !isProvided(schema, contentField) {
+				return nil
+			}
+
+			checksum, ok := stringFieldValue(schema, checksumField)
+			if !ok {
+				return nil
+			}
+
+			content, _ := conditionValue(schema, contentField)
+			sum, err := config.sum(content)
+			if err != nil {
+				return err
+			}
+
+			expected, err := hex.DecodeString(checksum)
+			if err != nil {
+				expected, err = base64.StdEncoding.DecodeString(checksum)
+			}
+			if err != nil || subtle.ConstantTimeCompare(expected, sum) != 1 {
+				return newValidationError(CodeChecksumMismatch, "field", contentField)
+			}
+
+			return nil
+		},
+	}
+}
+
+// sum returns the checksum of a string, []byte or uploaded file content
+func (r *checksumRule) sum(content interface{}) ([]byte, error) {
+	h := r.newHash()
+	switch v := content.(type) {
+	case string:
+		io.WriteString(h, v)
+	case []byte:
+		h.Write(v)
+	case UploadedFile:
+		if v.Path == "" {
+			return nil, fmt.Errorf("checksum validation requires the file on disk, it doesn't support file sinks")
+		}
+		f, err := os.Open(v.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		defer f.Close()
+		if _, err := io.Copy(h, f); err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("checksum validation requires string, []byte or uploaded file content and not a %T type", content)
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,93 @@
+package poxxy
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"ean13", EAN13(), "4006381333931", ""},
+		{"ean13 empty", EAN13(), "", ""},
+		{"ean13 bad check digit", EAN13(), "4006381333932", "invalid EAN-13 number"},
+		{"ean13 too short", EAN13(), "400638133393", "invalid EAN-13 number"},
+		{"isbn13", ISBN(), "978-2-07-036822-8", ""},
+		{"isbn10", ISBN(), "2-07-036822-X", ""},
+		{"isbn10 lowercase x", ISBN(), "207036822x", ""},
+		{"isbn13 bad check digit", ISBN(), "9782070368229", "invalid ISBN"},
+		{"isbn10 bad check digit", ISBN(), "2070368221", "invalid ISBN"},
+		{"isbn misplaced x", ISBN(), "20703682X2", "invalid ISBN"},
+		{"siren", SIREN(), "732 829 320", ""},
+		{"siren bad checksum", SIREN(), "732829321", "invalid SIREN number"},
+		{"siren letters", SIREN(), "73282932A", "invalid SIREN number"},
+		{"siret", SIRET(), "732 829 320 00074", ""},
+		{"siret bad checksum", SIRET(), "73282932000075", "invalid SIRET number"},
+		{"siret too short", SIRET(), "7328293200007", "invalid SIRET number"},
+		{"siret la poste head office", SIRET(), "35600000000048", ""},
+		{"siret la poste establishment", SIRET(), "35600000049837", ""},
+		{"siret not a string", SIRET(), 73282932000074, "siret validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "number")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChecksumOf(t *testing.T) {
+	content := "hello world"
+	sum := sha256.Sum256([]byte(content))
+
+	var body, checksum string
+	schema := NewSchema(
+		Value("content", &body),
+		Value("content_sha256", &checksum),
+		ChecksumOf("content", "content_sha256"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"content": content}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"content": content, "content_sha256": hex.EncodeToString(sum[:])}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"content": content, "content_sha256": strings.ToUpper(hex.EncodeToString(sum[:]))}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"content": content, "content_sha256": base64.StdEncoding.EncodeToString(sum[:])}))
+
+	err := schema.Apply(map[string]interface{}{"content": "hello world!", "content_sha256": hex.EncodeToString(sum[:])})
+	assert.EqualError(t, err, "content_sha256: does not match the checksum of content")
+
+	t.Run("uploaded file", func(t *testing.T) {
+		md5Sum := md5.Sum([]byte(content))
+
+		var file UploadedFile
+		var checksum string
+		schema := NewSchema(
+			File("file", &file),
+			Value("md5", &checksum),
+			ChecksumOf("file", "md5", ChecksumHash(md5.New)),
+		)
+
+		err := schema.ApplyMultipart(newMultipartRequest(t, map[string]string{"md5": hex.EncodeToString(md5Sum[:])}, map[string][]byte{"file": []byte(content)}))
+		t.Cleanup(func() { os.Remove(file.Path) })
+		assert.NoError(t, err)
+
+		err = schema.ApplyMultipart(newMultipartRequest(t, map[string]string{"md5": hex.EncodeToString(sum[:])}, map[string][]byte{"file": []byte(content)}))
+		t.Cleanup(func() { os.Remove(file.Path) })
+		assert.EqualError(t, err, "md5: does not match the checksum of file")
+	})
+}
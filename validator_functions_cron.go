@@ -0,0 +1,242 @@
+package poxxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression.
+// Each field holds the sorted values it matches, e.g. Minutes is [0 15 30 45] for "*/15".
+type CronSchedule struct {
+	Seconds     []int
+	Minutes     []int
+	Hours       []int
+	DaysOfMonth []int
+	Months      []int
+	// DaysOfWeek uses 0 for Sunday; 7 is accepted in expressions as Sunday too
+	DaysOfWeek []int
+
+	// Cron matches a day when either the day of month or the day of week matches if both are restricted
+	// (see cronRestricted)
+	domRestricted bool
+	dowRestricted bool
+}
+
+// cronField describes the range and names of a cron field
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSeconds     = cronField{name: "seconds", min: 0, max: 59}
+	cronMinutes     = cronField{name: "minutes", min: 0, max: 59}
+	cronHours       = cronField{name: "hours", min: 0, max: 23}
+	cronDaysOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonths      = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	cronDaysOfWeek = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// ParseCron parses a cron expression with 5 fields (minute hour day-of-month month day-of-week)
+// or 6 fields (with leading seconds). Fields accept "*", values, ranges ("1-5"), steps ("*/15", "0-30/5"),
+// lists ("1,15") and month/day names ("JAN", "MON"); "?" is accepted for the day fields.
+// Macros like "@daily" or "@hourly" are supported.
+//
+// Its signature allows using it with Convert to bind the parsed schedule.
+func ParseCron(expr string) (*CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) == 5 {
+		parts = append([]string{"0"}, parts...)
+	}
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("expected 5 or 6 fields, got %d", len(parts))
+	}
+
+	schedule := &CronSchedule{}
+	fields := []struct {
+		field cronField
+		dest  *[]int
+	}{
+		{cronSeconds, &schedule.Seconds},
+		{cronMinutes, &schedule.Minutes},
+		{cronHours, &schedule.Hours},
+		{cronDaysOfMonth, &schedule.DaysOfMonth},
+		{cronMonths, &schedule.Months},
+		{cronDaysOfWeek, &schedule.DaysOfWeek},
+	}
+
+	for i, f := range fields {
+		values, err := f.field.parse(parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.field.name, err)
+		}
+		*f.dest = values
+	}
+
+	schedule.domRestricted = cronRestricted(parts[3])
+	schedule.dowRestricted = cronRestricted(parts[5])
+
+	// 7 is an alias of Sunday
+	if len(schedule.DaysOfWeek) > 0 && schedule.DaysOfWeek[len(schedule.DaysOfWeek)-1] == 7 {
+		schedule.DaysOfWeek = schedule.DaysOfWeek[:len(schedule.DaysOfWeek)-1]
+		if len(schedule.DaysOfWeek) == 0 || schedule.DaysOfWeek[0] != 0 {
+			schedule.DaysOfWeek = append([]int{0}, schedule.DaysOfWeek...)
+		}
+	}
+
+	return schedule, nil
+}
+
+// cronRestricted reports whether a day field lists explicit values or ranges: like in Vixie cron,
+// "*" and its steps (e.g. "*/2") don't restrict the day, even if they don't match every day
+func cronRestricted(expr string) bool {
+	for _, item := range strings.Split(expr, ",") {
+		if rangeExpr, _, _ := strings.Cut(item, "/"); rangeExpr != "*" && rangeExpr != "?" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parse parses a cron field into its sorted values
+func (f cronField) parse(expr string) ([]int, error) {
+	if expr == "?" && (f.name == cronDaysOfMonth.name || f.name == cronDaysOfWeek.name) {
+		expr = "*"
+	}
+
+	matched := make([]bool, f.max+1)
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepExpr)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepExpr)
+			}
+			step = n
+		}
+
+		var start, end int
+		switch {
+		case rangeExpr == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangeExpr, "-"):
+			from, to, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if start, err = f.value(from); err != nil {
+				return nil, err
+			}
+			if end, err = f.value(to); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q", rangeExpr)
+			}
+		default:
+			var err error
+			if start, err = f.value(rangeExpr); err != nil {
+				return nil, err
+			}
+			end = start
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			matched[v] = true
+		}
+	}
+
+	var values []int
+	for v, ok := range matched {
+		if ok {
+			values = append(values, v)
+		}
+	}
+
+	return values, nil
+}
+
+// value parses a single value or name of a cron field
+func (f cronField) value(expr string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(expr)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// Matches reports whether the schedule fires at the given time (to the second)
+func (s *CronSchedule) Matches(t time.Time) bool {
+	if !containsInt(s.Seconds, t.Second()) || !containsInt(s.Minutes, t.Minute()) ||
+		!containsInt(s.Hours, t.Hour()) || !containsInt(s.Months, int(t.Month())) {
+		return false
+	}
+
+	domMatch := containsInt(s.DaysOfMonth, t.Day())
+	dowMatch := containsInt(s.DaysOfWeek, int(t.Weekday()))
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+// containsInt reports whether a sorted slice contains a value
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+		if value > v {
+			return false
+		}
+	}
+
+	return false
+}
+
+// CronExpr validator validates that a string is a 5 or 6-field cron expression (see ParseCron)
+func CronExpr() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "cron"}, func(str string) error {
+		if _, err := ParseCron(str); err != nil {
+			return newValidationError(CodeCron, "reason", err.Error())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+package poxxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    *CronSchedule
+		wantErr string
+	}{
+		{
+			name: "every 15 minutes",
+			expr: "*/15 * * * *",
+			want: &CronSchedule{Seconds: []int{0}, Minutes: []int{0, 15, 30, 45}},
+		},
+		{
+			name: "weekdays with seconds",
+			expr: "30 0 9 * * MON-FRI",
+			want: &CronSchedule{Seconds: []int{30}, Minutes: []int{0}, Hours: []int{9}, DaysOfWeek: []int{1, 2, 3, 4, 5}},
+		},
+		{
+			name: "lists and names",
+			expr: "0 8,18 1 jan,jul ?",
+			want: &CronSchedule{Seconds: []int{0}, Minutes: []int{0}, Hours: []int{8, 18}, DaysOfMonth: []int{1}, Months: []int{1, 7}},
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 5-7",
+			want: &CronSchedule{Seconds: []int{0}, Minutes: []int{0}, Hours: []int{0}, DaysOfWeek: []int{0, 5, 6}},
+		},
+		{name: "macro", expr: "@daily", want: &CronSchedule{Seconds: []int{0}, Minutes: []int{0}, Hours: []int{0}}},
+		{name: "too few fields", expr: "* * * *", wantErr: "expected 5 or 6 fields, got 4"},
+		{name: "out of range", expr: "60 * * * *", wantErr: "minutes: value 60 out of range [0, 59]"},
+		{name: "bad step", expr: "*/0 * * * *", wantErr: `minutes: invalid step "0"`},
+		{name: "reversed range", expr: "* 5-1 * * *", wantErr: `hours: invalid range "5-1"`},
+		{name: "unknown name", expr: "* * * FOO *", wantErr: `month: invalid value "FOO"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.expr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			// Fields left nil in the expectation match every value and aren't compared
+			for _, pair := range [][2][]int{
+				{tt.want.Seconds, schedule.Seconds},
+				{tt.want.Minutes, schedule.Minutes},
+				{tt.want.Hours, schedule.Hours},
+				{tt.want.DaysOfMonth, schedule.DaysOfMonth},
+				{tt.want.Months, schedule.Months},
+				{tt.want.DaysOfWeek, schedule.DaysOfWeek},
+			} {
+				if pair[0] != nil {
+					assert.Equal(t, pair[0], pair[1])
+				}
+			}
+		})
+	}
+}
+
+func TestCronSchedule_Matches(t *testing.T) {
+	// 2024-03-15 is a Friday
+	friday := time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+
+	schedule, err := ParseCron("0 9 * * MON-FRI")
+	require.NoError(t, err)
+	assert.True(t, schedule.Matches(friday))
+	assert.False(t, schedule.Matches(friday.Add(time.Minute)))
+	assert.False(t, schedule.Matches(friday.AddDate(0, 0, 1)))
+
+	// Both day fields restricted: either one matches
+	schedule, err = ParseCron("0 9 1 * FRI")
+	require.NoError(t, err)
+	assert.True(t, schedule.Matches(friday))
+	assert.True(t, schedule.Matches(time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, schedule.Matches(time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC)))
+
+	// Steps of "*" don't restrict the day of month: both fields must match
+	schedule, err = ParseCron("0 9 */2 * FRI")
+	require.NoError(t, err)
+	assert.True(t, schedule.Matches(friday))
+	assert.False(t, schedule.Matches(time.Date(2024, 3, 17, 9, 0, 0, 0, time.UTC)), "odd sunday")
+	assert.False(t, schedule.Matches(time.Date(2024, 3, 22, 9, 0, 0, 0, time.UTC)), "even friday")
+
+	schedule, err = ParseCron("0 9 1-31/2 * FRI")
+	require.NoError(t, err)
+	assert.True(t, schedule.Matches(time.Date(2024, 3, 17, 9, 0, 0, 0, time.UTC)), "explicit ranges restrict the day")
+}
+
+func TestCronExpr(t *testing.T) {
+	var expr string
+	schema := NewSchema(Value("schedule", &expr, WithValidators(CronExpr())))
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"schedule": "0 */2 * * *"}))
+
+	err := schema.Apply(map[string]interface{}{"schedule": "0 */2 * *"})
+	assert.EqualError(t, err, "schedule: invalid cron expression: expected 5 or 6 fields, got 4")
+
+	var schedule CronSchedule
+	schema = NewSchema(Convert("schedule", &schedule, ParseCron))
+	require.NoError(t, schema.Apply(map[string]interface{}{"schedule": "@hourly"}))
+	assert.Equal(t, []int{0}, schedule.Minutes)
+	assert.Len(t, schedule.Hours, 24)
+}
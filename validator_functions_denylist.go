@@ -0,0 +1,153 @@
+package poxxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+)
+
+// NotMatchingAny validator validates that a string doesn't match any of the given regular expressions,
+// e.g. NotMatchingAny(`(?i)^admin`, `(?i)support`) for reserved usernames.
+// It panics if a pattern doesn't compile, as validators are declared at schema construction.
+func NotMatchingAny(patterns ...string) Validator {
+	regexes := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		regexes[i] = regexp.MustCompile(pattern)
+	}
+
+	return newStringValidator(ConstraintInfo{Kind: "not_matching_any", Params: map[string]interface{}{"patterns": patterns}}, func(str string) error {
+		for _, regex := range regexes {
+			if regex.MatchString(str) {
+				return newValidationError(CodeNotMatchingAny)
+			}
+		}
+		return nil
+	})
+}
+
+// Denylist holds forbidden words. Implementations receive words already normalized by NotInDenylist
+// (case-folded, and leet-speak decoded with WithLeetSpeak), so they may be backed by a database or a remote service.
+type Denylist interface {
+	Contains(word string) bool
+}
+
+// wordSet is a Denylist backed by a set of case-folded words
+type wordSet struct {
+	words map[string]bool
+	// Length in runes of the longest word, bounding the substrings looked up by WithSubstringMatch
+	maxLength int
+}
+
+// Contains reports whether the word is in the set
+func (s *wordSet) Contains(word string) bool {
+	return s.words[word]
+}
+
+// NewDenylist creates a Denylist of the given words (case-insensitive)
+func NewDenylist(words ...string) Denylist {
+	set := &wordSet{words: make(map[string]bool, len(words))}
+	for _, word := range words {
+		folded := cases.Fold().String(word)
+		set.words[folded] = true
+		set.maxLength = max(set.maxLength, utf8.RuneCountInString(folded))
+	}
+
+	return set
+}
+
+// DenylistOption represents a configuration option for NotInDenylist
+type DenylistOption func(*denylistConfig)
+
+// denylistConfig holds the configuration of a denylist validator
+type denylistConfig struct {
+	leetSpeak bool
+	substring bool
+}
+
+// WithLeetSpeak decodes leet-speak before matching (e.g. "h4ck3r" matches "hacker")
+func WithLeetSpeak() DenylistOption {
+	return func(c *denylistConfig) {
+		c.leetSpeak = true
+	}
+}
+
+// WithSubstringMatch matches forbidden words anywhere in the value (e.g. "xbadwordx"), not only as whole words.
+// It catches more evasions but also more false positives. It requires a Denylist created by NewDenylist,
+// the substrings looked up being bounded by the length of its longest word.
+func WithSubstringMatch() DenylistOption {
+	return func(c *denylistConfig) {
+		c.substring = true
+	}
+}
+
+// leetSpeakReplacer decodes the common leet-speak substitutions
+var leetSpeakReplacer = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "8", "b", "@", "a", "$", "s", "!", "i", "|", "l",
+)
+
+// NotInDenylist validator validates that a string doesn't contain a word of the denylist, e.g. for user-generated
+// names. The value is case-folded and split into words on non-letter characters; use WithLeetSpeak to decode
+// leet-speak and WithSubstringMatch to also match inside words.
+// It panics if WithSubstringMatch is used with a Denylist not created by NewDenylist.
+func NotInDenylist(list Denylist, opts ...DenylistOption) Validator {
+	config := &denylistConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	maxLength := 0
+	if config.substring {
+		set, ok := list.(*wordSet)
+		if !ok {
+			panic(fmt.Sprintf("WithSubstringMatch doesn't support %T", list))
+		}
+		maxLength = set.maxLength
+	}
+
+	return newStringValidator(ConstraintInfo{Kind: "not_in_denylist"}, func(str string) error {
+		normalized := cases.Fold().String(str)
+		if config.leetSpeak {
+			normalized = leetSpeakReplacer.Replace(normalized)
+		}
+
+		words := strings.FieldsFunc(normalized, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+
+		for _, word := range words {
+			if denylisted(list, word, maxLength) {
+				return newValidationError(CodeDenylisted)
+			}
+		}
+
+		// Also catch words split by separators, e.g. "b.a.d"
+		if config.substring && denylisted(list, strings.Join(words, ""), maxLength) {
+			return newValidationError(CodeDenylisted)
+		}
+
+		return nil
+	})
+}
+
+// denylisted reports whether the word, or one of its substrings of at most maxLength runes when maxLength
+// is set, is in the denylist
+func denylisted(list Denylist, word string, maxLength int) bool {
+	if maxLength == 0 {
+		return list.Contains(word)
+	}
+
+	runes := []rune(word)
+	for i := range runes {
+		for j := i + 1; j <= min(i+maxLength, len(runes)); j++ {
+			if list.Contains(string(runes[i:j])) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
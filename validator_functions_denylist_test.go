@@ -0,0 +1,76 @@
+package poxxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotMatchingAny(t *testing.T) {
+	validator := NotMatchingAny(`(?i)^admin`, `support`)
+
+	assert.NoError(t, validator.Validate("john", "username"))
+	assert.NoError(t, validator.Validate("", "username"))
+	assert.EqualError(t, validator.Validate("Administrator", "username"), "value is not allowed")
+	assert.EqualError(t, validator.Validate("support-team", "username"), "value is not allowed")
+
+	assert.Panics(t, func() { NotMatchingAny(`(`) })
+}
+
+func TestNotInDenylist(t *testing.T) {
+	denylist := NewDenylist("Hacker", "spam")
+
+	tests := []struct {
+		name      string
+		validator Validator
+		value     string
+		wantErr   bool
+	}{
+		{"clean", NotInDenylist(denylist), "john doe", false},
+		{"whole word", NotInDenylist(denylist), "the_HACKER", true},
+		{"inside a word", NotInDenylist(denylist), "spammer", false},
+		{"leet speak ignored", NotInDenylist(denylist), "h4ck3r", false},
+		{"leet speak", NotInDenylist(denylist, WithLeetSpeak()), "h4ck3r", true},
+		{"substring", NotInDenylist(denylist, WithSubstringMatch()), "spammer", true},
+		{"split word", NotInDenylist(denylist, WithSubstringMatch()), "s.p.a.m", true},
+		{"leet speak and substring", NotInDenylist(denylist, WithLeetSpeak(), WithSubstringMatch()), "xxsp4mxx", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "name")
+			if tt.wantErr {
+				assert.EqualError(t, err, "contains a forbidden word")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("substring lookups are bounded", func(t *testing.T) {
+		var lookups int
+		list := denylistFunc(func(word string) bool {
+			lookups++
+			return false
+		})
+
+		assert.False(t, denylisted(list, strings.Repeat("a", 1000), 4))
+		assert.LessOrEqual(t, lookups, 4000)
+
+		value := strings.Repeat("a", 10000) + "spam"
+		assert.EqualError(t, NotInDenylist(denylist, WithSubstringMatch()).Validate(value, "name"), "contains a forbidden word")
+	})
+
+	t.Run("substring match requires an in-memory denylist", func(t *testing.T) {
+		assert.Panics(t, func() { NotInDenylist(denylistFunc(func(string) bool { return false }), WithSubstringMatch()) })
+		assert.NotPanics(t, func() { NotInDenylist(denylistFunc(func(string) bool { return false })) })
+	})
+}
+
+// denylistFunc is a Denylist backed by a function, like a database lookup
+type denylistFunc func(word string) bool
+
+func (f denylistFunc) Contains(word string) bool {
+	return f(word)
+}
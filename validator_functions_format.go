@@ -0,0 +1,54 @@
+package poxxy
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	hexColorRegex = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	rgbColorRegex = regexp.MustCompile(`^rgba?\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*(?:,\s*([0-9]*\.?[0-9]+)\s*)?\)$`)
+)
+
+// HexColor validator validates that a string is a hexadecimal color: #rgb, #rgba, #rrggbb or #rrggbbaa
+func HexColor() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "hex_color"}, func(str string) error {
+		if !hexColorRegex.MatchString(str) {
+			return newValidationError(CodeHexColor)
+		}
+		return nil
+	})
+}
+
+// RGBColor validator validates that a string is a CSS rgb() or rgba() color, e.g. "rgb(255, 0, 0)"
+// or "rgba(255, 0, 0, 0.5)". Channels are between 0 and 255 and the alpha between 0 and 1.
+func RGBColor() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "rgb_color"}, func(str string) error {
+		str = strings.TrimSpace(str)
+		matches := rgbColorRegex.FindStringSubmatch(str)
+		if matches == nil {
+			return newValidationError(CodeRGBColor)
+		}
+
+		// rgb() has no alpha, rgba() requires one
+		hasAlpha := matches[4] != ""
+		if hasAlpha != strings.HasPrefix(str, "rgba") {
+			return newValidationError(CodeRGBColor)
+		}
+
+		for _, channel := range matches[1:4] {
+			if n, _ := strconv.Atoi(channel); n > 255 {
+				return newValidationError(CodeRGBColor)
+			}
+		}
+
+		if hasAlpha {
+			if alpha, err := strconv.ParseFloat(matches[4], 64); err != nil || alpha > 1 {
+				return newValidationError(CodeRGBColor)
+			}
+		}
+
+		return nil
+	})
+}
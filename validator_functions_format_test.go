@@ -0,0 +1,43 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"hex short", HexColor(), "#f00", ""},
+		{"hex long", HexColor(), "#FF0000", ""},
+		{"hex alpha", HexColor(), "#ff000080", ""},
+		{"hex empty", HexColor(), "", ""},
+		{"hex missing hash", HexColor(), "ff0000", "must be a hexadecimal color like #ff0000"},
+		{"hex short alpha", HexColor(), "#f008", ""},
+		{"hex bad length", HexColor(), "#ff000", "must be a hexadecimal color like #ff0000"},
+		{"hex bad digit", HexColor(), "#gg0000", "must be a hexadecimal color like #ff0000"},
+		{"rgb", RGBColor(), "rgb(255, 0, 0)", ""},
+		{"rgba", RGBColor(), "rgba(255,0,0,0.5)", ""},
+		{"rgb channel too high", RGBColor(), "rgb(256, 0, 0)", "must be an RGB color like rgb(255, 0, 0)"},
+		{"rgb with alpha", RGBColor(), "rgb(255, 0, 0, 0.5)", "must be an RGB color like rgb(255, 0, 0)"},
+		{"rgba without alpha", RGBColor(), "rgba(255, 0, 0)", "must be an RGB color like rgb(255, 0, 0)"},
+		{"rgba alpha too high", RGBColor(), "rgba(255, 0, 0, 1.5)", "must be an RGB color like rgb(255, 0, 0)"},
+		{"rgb not a string", RGBColor(), 255, "rgb_color validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "color")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
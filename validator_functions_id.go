@@ -0,0 +1,48 @@
+package poxxy
+
+import "regexp"
+
+var (
+	// UUIDs are 32 hexadecimal digits in 8-4-4-4-12 groups
+	uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// ULIDs are 26 Crockford base32 characters; the first one is at most 7 as they encode 128 bits
+	ulidRegex = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	// KSUIDs are 27 base62 characters
+	ksuidRegex = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+)
+
+// maxKSUID is the largest KSUID, encoding 160 bits set to 1.
+// The base62 alphabet is in ASCII order, so KSUIDs compare as strings.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// UUID validator validates that a string is a UUID in its canonical form, e.g. "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+// Any version is accepted, as are uppercase UUIDs.
+func UUID() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "uuid"}, func(str string) error {
+		if !uuidRegex.MatchString(str) {
+			return newValidationError(CodeUUID)
+		}
+		return nil
+	})
+}
+
+// ULID validator validates that a string is a ULID, e.g. "01ARZ3NDEKTSV4RRFFQ69G5FAV".
+// Lowercase ULIDs are accepted.
+func ULID() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "ulid"}, func(str string) error {
+		if !ulidRegex.MatchString(str) {
+			return newValidationError(CodeULID)
+		}
+		return nil
+	})
+}
+
+// KSUID validator validates that a string is a KSUID, e.g. "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+func KSUID() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "ksuid"}, func(str string) error {
+		if !ksuidRegex.MatchString(str) || str > maxKSUID {
+			return newValidationError(CodeKSUID)
+		}
+		return nil
+	})
+}
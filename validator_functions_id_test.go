@@ -0,0 +1,45 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"uuid", UUID(), "f47ac10b-58cc-4372-a567-0e02b2c3d479", ""},
+		{"uuid uppercase", UUID(), "F47AC10B-58CC-4372-A567-0E02B2C3D479", ""},
+		{"uuid without hyphens", UUID(), "f47ac10b58cc4372a5670e02b2c3d479", "invalid UUID"},
+		{"uuid braces", UUID(), "{f47ac10b-58cc-4372-a567-0e02b2c3d479}", "invalid UUID"},
+		{"ulid", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAV", ""},
+		{"ulid lowercase", ULID(), "01arz3ndektsv4rrffq69g5fav", ""},
+		{"ulid max", ULID(), "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", ""},
+		{"ulid empty", ULID(), "", ""},
+		{"ulid overflow", ULID(), "8ZZZZZZZZZZZZZZZZZZZZZZZZZ", "invalid ULID"},
+		{"ulid excluded letter", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAU", "invalid ULID"},
+		{"ulid too short", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FA", "invalid ULID"},
+		{"ksuid", KSUID(), "0ujtsYcgvSTl8PAuAdqWYSMnLOv", ""},
+		{"ksuid max", KSUID(), "aWgEPTl1tmebfsQzFP4bxwgy80V", ""},
+		{"ksuid overflow", KSUID(), "aWgEPTl1tmebfsQzFP4bxwgy80W", "invalid KSUID"},
+		{"ksuid bad character", KSUID(), "0ujtsYcgvSTl8PAuAdqWYSMnLO-", "invalid KSUID"},
+		{"ksuid too long", KSUID(), "0ujtsYcgvSTl8PAuAdqWYSMnLOvx", "invalid KSUID"},
+		{"ksuid not a string", KSUID(), 42, "ksuid validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "id")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
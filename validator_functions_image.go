@@ -0,0 +1,71 @@
+package poxxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// newImageValidator creates a described validator for uploaded images (see File).
+// nil is considered valid (use the Required() validator to enforce presence);
+// files which are not PNG, JPEG or GIF images are rejected.
+func newImageValidator(info ConstraintInfo, fn func(file *UploadedFile) error) Validator {
+	return newDescribedValidator(info, func(value interface{}, fieldName string) error {
+		var file *UploadedFile
+		switch v := value.(type) {
+		case nil:
+			return nil
+		case UploadedFile:
+			file = &v
+		case *UploadedFile:
+			if v == nil {
+				return nil
+			}
+			file = v
+		default:
+			return fmt.Errorf("%s validation requires an uploaded file and not a %T type", info.Kind, value)
+		}
+
+		if file.ImageFormat == "" {
+			return newValidationError(CodeImage)
+		}
+
+		return fn(file)
+	})
+}
+
+// ImageDimensions validator validates that an uploaded image is at most maxWidth x maxHeight pixels.
+// The dimensions are read from the header of the image, which is never decoded, so decompression bombs
+// (small files declaring huge dimensions) are rejected without allocating their pixels.
+func ImageDimensions(maxWidth, maxHeight int) Validator {
+	info := ConstraintInfo{Kind: "image_dimensions", Params: map[string]interface{}{"max_width": maxWidth, "max_height": maxHeight}}
+	errDimensions := newValidationError(CodeImageDimensions, "width", strconv.Itoa(maxWidth), "height", strconv.Itoa(maxHeight))
+
+	return newImageValidator(info, func(file *UploadedFile) error {
+		if file.Width > maxWidth || file.Height > maxHeight {
+			return errDimensions
+		}
+		return nil
+	})
+}
+
+// ImageFormats validator validates that an uploaded file is an image in one of the given formats
+// ("png", "jpeg" or "gif"; "jpg" is accepted as an alias of "jpeg"), detected from its content
+func ImageFormats(formats ...string) Validator {
+	allowed := make(map[string]bool, len(formats))
+	for _, format := range formats {
+		format = strings.ToLower(format)
+		if format == "jpg" {
+			format = "jpeg"
+		}
+		allowed[format] = true
+	}
+	errFormat := newValidationError(CodeImageFormat, "formats", strings.Join(formats, ", "))
+
+	return newImageValidator(ConstraintInfo{Kind: "image_formats", Params: map[string]interface{}{"formats": formats}}, func(file *UploadedFile) error {
+		if !allowed[file.ImageFormat] {
+			return errFormat
+		}
+		return nil
+	})
+}
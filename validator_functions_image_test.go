@@ -0,0 +1,107 @@
+package poxxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngBomb returns the header of a PNG image declaring the given dimensions, without pixels
+func pngBomb(width, height uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], width)
+	binary.BigEndian.PutUint32(ihdr[8:], height)
+	ihdr[12] = 8 // Bit depth, grayscale
+
+	var b bytes.Buffer
+	b.Write(pngHeader)
+	binary.Write(&b, binary.BigEndian, uint32(13))
+	b.Write(ihdr)
+	binary.Write(&b, binary.BigEndian, crc32.ChecksumIEEE(ihdr))
+	return b.Bytes()
+}
+
+func TestImageValidators(t *testing.T) {
+	var pngContent, jpegContent bytes.Buffer
+	require.NoError(t, png.Encode(&pngContent, image.NewGray(image.Rect(0, 0, 40, 30))))
+	require.NoError(t, jpeg.Encode(&jpegContent, image.NewGray(image.Rect(0, 0, 300, 200)), nil))
+
+	upload := func(t *testing.T, content []byte, validators ...Validator) (UploadedFile, error) {
+		var avatar UploadedFile
+		schema := NewSchema(File("avatar", &avatar, WithValidators(validators...)))
+		err := schema.ApplyMultipart(newMultipartRequest(t, nil, map[string][]byte{"avatar": content}))
+		t.Cleanup(func() { os.Remove(avatar.Path) })
+		return avatar, err
+	}
+
+	t.Run("header is read while streaming", func(t *testing.T) {
+		avatar, err := upload(t, jpegContent.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", avatar.ImageFormat)
+		assert.Equal(t, 300, avatar.Width)
+		assert.Equal(t, 200, avatar.Height)
+
+		// Camera pictures hold EXIF, ICC and XMP segments before the dimensions
+		var photo bytes.Buffer
+		photo.Write(jpegContent.Bytes()[:2]) // Start of image
+		for range 3 {
+			photo.Write([]byte{0xFF, 0xE1, 0xFF, 0xFF}) // APP1 segment of 64 KiB
+			photo.Write(make([]byte, 0xFFFF-2))
+		}
+		photo.Write(jpegContent.Bytes()[2:])
+
+		avatar, err = upload(t, photo.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", avatar.ImageFormat)
+		assert.Equal(t, 300, avatar.Width)
+		stored, err := os.ReadFile(avatar.Path)
+		require.NoError(t, err)
+		assert.Equal(t, photo.Bytes(), stored)
+		assert.Equal(t, int64(photo.Len()), avatar.Size)
+
+		avatar, err = upload(t, []byte("plain text"))
+		require.NoError(t, err)
+		assert.Empty(t, avatar.ImageFormat)
+	})
+
+	t.Run("dimensions", func(t *testing.T) {
+		_, err := upload(t, pngContent.Bytes(), ImageDimensions(256, 256))
+		assert.NoError(t, err)
+
+		_, err = upload(t, jpegContent.Bytes(), ImageDimensions(256, 256))
+		assert.EqualError(t, err, "avatar: image must be at most 256x256 pixels")
+
+		_, err = upload(t, pngBomb(100000, 100000), ImageDimensions(4096, 4096))
+		assert.EqualError(t, err, "avatar: image must be at most 4096x4096 pixels")
+	})
+
+	t.Run("formats", func(t *testing.T) {
+		_, err := upload(t, jpegContent.Bytes(), ImageFormats("png", "jpg"))
+		assert.NoError(t, err)
+
+		_, err = upload(t, pngContent.Bytes(), ImageFormats("jpeg"))
+		assert.EqualError(t, err, "avatar: image format must be one of jpeg")
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		_, err := upload(t, []byte("plain text"), ImageFormats("png"))
+		assert.EqualError(t, err, "avatar: file is not a valid image")
+
+		_, err = upload(t, append(append([]byte{}, pngHeader...), "corrupted"...), ImageDimensions(10, 10))
+		assert.EqualError(t, err, "avatar: file is not a valid image")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		assert.NoError(t, ImageDimensions(10, 10).Validate(nil, "avatar"))
+		assert.EqualError(t, ImageDimensions(10, 10).Validate("avatar.png", "avatar"), "image_dimensions validation requires an uploaded file and not a string type")
+	})
+}
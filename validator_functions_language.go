@@ -0,0 +1,22 @@
+package poxxy
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// LanguageTag validator validates that a string is a well-formed BCP 47 language tag with known subtags,
+// e.g. "fr", "en-US" or "zh-Hant-TW". The check is case-insensitive; POSIX-style locales like "en_US" are rejected.
+func LanguageTag() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "language_tag"}, func(str string) error {
+		if strings.Contains(str, "_") {
+			return newValidationError(CodeLanguageTag)
+		}
+
+		if _, err := language.Parse(str); err != nil {
+			return newValidationError(CodeLanguageTag)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		wantErr string
+	}{
+		{"fr", ""},
+		{"en-US", ""},
+		{"zh-hant-tw", ""},
+		{"sr-Latn-RS", ""},
+		{"", ""},
+		{"en_US", "invalid language tag"},
+		{"english", "invalid language tag"},
+		{"en-", "invalid language tag"},
+		{"xx-YYYY-ZZZ", "invalid language tag"},
+		{42, "language_tag validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		err := LanguageTag().Validate(tt.value, "locale")
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, "%v", tt.value)
+		} else {
+			assert.NoError(t, err, "%v", tt.value)
+		}
+	}
+}
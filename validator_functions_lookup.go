@@ -0,0 +1,243 @@
+package poxxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LookupFunc reports whether a value exists in a store (database, cache, remote API, ...)
+type LookupFunc[T comparable] func(ctx context.Context, value T) (bool, error)
+
+// BatchLookupFunc reports which of the given values exist in a store, in a single call.
+// Values missing from the returned map are considered as not existing.
+type BatchLookupFunc[T comparable] func(ctx context.Context, values []T) (map[T]bool, error)
+
+// lookupConfig holds the configuration of a lookup validator
+type lookupConfig[T comparable] struct {
+	batch    BatchLookupFunc[T]
+	cacheTTL time.Duration
+}
+
+// LookupOption represents a configuration option for ExistsIn and NotExistsIn
+type LookupOption[T comparable] func(*lookupConfig[T])
+
+// WithBatchLookup uses a batched lookup to validate slices in a single call instead of one call per element
+func WithBatchLookup[T comparable](batch BatchLookupFunc[T]) LookupOption[T] {
+	return func(c *lookupConfig[T]) {
+		c.batch = batch
+	}
+}
+
+// WithLookupCache caches lookup results for the given duration.
+// The cache is shared by every Apply of the schema declaring the validator.
+func WithLookupCache[T comparable](ttl time.Duration) LookupOption[T] {
+	return func(c *lookupConfig[T]) {
+		c.cacheTTL = ttl
+	}
+}
+
+// lookupCacheEntry holds a cached lookup result
+type lookupCacheEntry struct {
+	exists    bool
+	expiresAt time.Time
+}
+
+// presenceValidator is implemented by validators looking up the zero value of the fields present in the
+// input, for wrappers like Remote to give them the presence of the field
+type presenceValidator interface {
+	validatePresent(ctx context.Context, value interface{}, fieldName string, present bool) error
+}
+
+// lookupValidator validates values against a key-value store
+type lookupValidator[T comparable] struct {
+	lookup LookupFunc[T]
+	config *lookupConfig[T]
+	// want is the expected result of the lookup: true for ExistsIn, false for NotExistsIn
+	want bool
+	msg  string
+
+	mu    *sync.Mutex
+	cache map[T]lookupCacheEntry
+}
+
+// ExistsIn validator validates that a value exists in a store, e.g. "category_id must exist".
+// Slices of values are validated element by element, or in a single call with WithBatchLookup.
+// The lookup receives the context of the schema (see WithContext). Nil values, and the zero values of fields
+// missing from the input, are considered valid and not looked up: an explicit "id": 0 is looked up.
+func ExistsIn[T comparable](lookup LookupFunc[T], opts ...LookupOption[T]) Validator {
+	return newLookupValidator(lookup, true, opts)
+}
+
+// NotExistsIn validator validates that a value doesn't exist in a store yet, e.g. "email must be unique".
+// It supports the same options as ExistsIn.
+func NotExistsIn[T comparable](lookup LookupFunc[T], opts ...LookupOption[T]) Validator {
+	return newLookupValidator(lookup, false, opts)
+}
+
+// newLookupValidator creates a lookup validator
+func newLookupValidator[T comparable](lookup LookupFunc[T], want bool, opts []LookupOption[T]) *lookupValidator[T] {
+	config := &lookupConfig[T]{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return &lookupValidator[T]{
+		lookup: lookup,
+		config: config,
+		want:   want,
+		mu:     &sync.Mutex{},
+		cache:  make(map[T]lookupCacheEntry),
+	}
+}
+
+// Validate validates a value using a background context
+func (v *lookupValidator[T]) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext validates a value or a slice of values against the store. Without the schema, zero values
+// are considered as missing.
+func (v *lookupValidator[T]) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	return v.validatePresent(ctx, value, fieldName, false)
+}
+
+// validateWithSchema validates a value against the store, looking up the zero value of a field present in the input
+func (v *lookupValidator[T]) validateWithSchema(schema *Schema, value interface{}, fieldName string) error {
+	return v.validatePresent(schema.Context(), value, fieldName, schema != nil && schema.IsFieldPresent(fieldName))
+}
+
+// validatePresent validates a value or a slice of values against the store. The zero value is only looked up
+// when present is set.
+func (v *lookupValidator[T]) validatePresent(ctx context.Context, value interface{}, fieldName string, present bool) error {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case T:
+		var zero T
+		if typed == zero && !present {
+			return nil
+		}
+
+		results, err := v.resolve(ctx, []T{typed})
+		if err != nil {
+			return err
+		}
+
+		if results[typed] != v.want {
+			return v.failure(typed)
+		}
+		return nil
+	case []T:
+		results, err := v.resolve(ctx, typed)
+		if err != nil {
+			return err
+		}
+
+		var errs ElementErrors
+		for i, item := range typed {
+			if results[item] != v.want {
+				errs = append(errs, ElementError{Index: i, Error: v.failure(item)})
+			}
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	default:
+		return fmt.Errorf("expected type %T or %T, got %T", *new(T), []T{}, value)
+	}
+}
+
+// resolve returns the lookup results of the given values, using the cache when enabled
+func (v *lookupValidator[T]) resolve(ctx context.Context, values []T) (map[T]bool, error) {
+	results := make(map[T]bool, len(values))
+
+	var missing []T
+	now := time.Now()
+	v.mu.Lock()
+	for _, value := range values {
+		if _, seen := results[value]; seen {
+			continue
+		}
+
+		if entry, ok := v.cache[value]; ok && now.Before(entry.expiresAt) {
+			results[value] = entry.exists
+			continue
+		}
+
+		results[value] = false
+		missing = append(missing, value)
+	}
+	v.mu.Unlock()
+
+	if len(missing) == 0 {
+		return results, nil
+	}
+
+	if v.config.batch != nil && len(missing) > 1 {
+		found, err := v.config.batch(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("lookup failed: %w", err)
+		}
+
+		for _, value := range missing {
+			results[value] = found[value]
+		}
+	} else {
+		for _, value := range missing {
+			exists, err := v.lookup(ctx, value)
+			if err != nil {
+				return nil, fmt.Errorf("lookup failed: %w", err)
+			}
+			results[value] = exists
+		}
+	}
+
+	if v.config.cacheTTL > 0 {
+		v.mu.Lock()
+		for _, value := range missing {
+			v.cache[value] = lookupCacheEntry{exists: results[value], expiresAt: now.Add(v.config.cacheTTL)}
+		}
+		v.mu.Unlock()
+	}
+
+	return results, nil
+}
+
+// failure returns the validation error of a value
+func (v *lookupValidator[T]) failure(value T) error {
+	code := CodeNotExists
+	if v.want {
+		code = CodeExists
+	}
+
+	if v.msg != "" {
+		return &ValidationError{Code: code, Message: v.msg}
+	}
+
+	return newValidationError(code, "value", fmt.Sprintf("%v", value))
+}
+
+// WithMessage sets a custom error message for the validator
+func (v *lookupValidator[T]) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the validator
+func (v *lookupValidator[T]) Describe() ConstraintInfo {
+	if v.want {
+		return ConstraintInfo{Kind: "exists_in"}
+	}
+
+	return ConstraintInfo{Kind: "not_exists_in"}
+}
+
+// performsIO implements ioValidator interface
+func (v *lookupValidator[T]) performsIO() bool {
+	return true
+}
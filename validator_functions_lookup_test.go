@@ -0,0 +1,113 @@
+package poxxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExistsIn(t *testing.T) {
+	categories := map[int]bool{1: true, 2: true}
+
+	calls := 0
+	lookup := func(ctx context.Context, id int) (bool, error) {
+		calls++
+		return categories[id], nil
+	}
+
+	t.Run("single value", func(t *testing.T) {
+		var categoryID int
+		schema := NewSchema(Value("category_id", &categoryID, WithValidators(ExistsIn(lookup))))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"category_id": 1}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"category_id": 3}), "category_id: value 3 does not exist")
+	})
+
+	t.Run("optional field not provided", func(t *testing.T) {
+		calls = 0
+		var categoryID int
+		schema := NewSchema(Value("category_id", &categoryID, WithValidators(ExistsIn(lookup))))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{}))
+		assert.Equal(t, 0, calls)
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"category_id": 0}), "category_id: value 0 does not exist")
+		assert.Equal(t, 1, calls)
+
+		remote := NewSchema(Value("category_id", &categoryID, WithValidators(Remote(ExistsIn(lookup)))))
+		assert.NoError(t, remote.Apply(map[string]interface{}{}))
+		assert.Error(t, remote.Apply(map[string]interface{}{"category_id": 0}))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("slice with batched lookup", func(t *testing.T) {
+		var batches [][]int
+		batch := func(ctx context.Context, ids []int) (map[int]bool, error) {
+			batches = append(batches, ids)
+			found := make(map[int]bool)
+			for _, id := range ids {
+				found[id] = categories[id]
+			}
+			return found, nil
+		}
+
+		var ids []int
+		schema := NewSchema(Slice("category_ids", &ids, WithValidators(ExistsIn(lookup, WithBatchLookup(batch)))))
+
+		err := schema.Apply(map[string]interface{}{"category_ids": []interface{}{1, 4, 2, 4}})
+		require.Error(t, err)
+		assert.Equal(t, "category_ids: element 1: value 4 does not exist; element 3: value 4 does not exist", err.Error())
+		assert.Equal(t, [][]int{{1, 4, 2}}, batches)
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		calls = 0
+		validator := ExistsIn(lookup, WithLookupCache[int](time.Minute))
+
+		assert.NoError(t, validator.Validate(1, "id"))
+		assert.NoError(t, validator.Validate(1, "id"))
+		assert.Error(t, validator.Validate(5, "id"))
+		assert.Error(t, validator.Validate(5, "id"))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("context is passed to the lookup", func(t *testing.T) {
+		type key struct{}
+		var got interface{}
+		validator := ExistsIn(func(ctx context.Context, id int) (bool, error) {
+			got = ctx.Value(key{})
+			return true, nil
+		})
+
+		var id int
+		schema := NewSchema(Value("id", &id, WithValidators(validator)))
+		ctx := context.WithValue(context.Background(), key{}, "request")
+
+		require.NoError(t, schema.Apply(map[string]interface{}{"id": 1}, WithContext(ctx)))
+		assert.Equal(t, "request", got)
+	})
+
+	t.Run("lookup error", func(t *testing.T) {
+		validator := ExistsIn(func(ctx context.Context, id int) (bool, error) {
+			return false, errors.New("connection refused")
+		})
+
+		assert.EqualError(t, validator.Validate(1, "id"), "lookup failed: connection refused")
+	})
+}
+
+func TestNotExistsIn(t *testing.T) {
+	taken := func(ctx context.Context, email string) (bool, error) {
+		return email == "john@example.com", nil
+	}
+
+	validator := NotExistsIn(taken)
+	assert.NoError(t, validator.Validate("jane@example.com", "email"))
+	assert.EqualError(t, validator.Validate("john@example.com", "email"), "value john@example.com already exists")
+	assert.EqualError(t, validator.WithMessage("email already taken").Validate("john@example.com", "email"), "email already taken")
+	assert.NoError(t, validator.Validate(nil, "email"))
+}
@@ -0,0 +1,80 @@
+package poxxy
+
+import (
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+)
+
+// maxPort is the highest TCP/UDP port
+const maxPort = 65535
+
+// firstUnprivilegedPort is the lowest port that doesn't require privileges to listen on
+const firstUnprivilegedPort = 1024
+
+var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*\.?$`)
+
+// PortOption represents a configuration option for Port and HostPort
+type PortOption func(*portConfig)
+
+// portConfig holds the range of ports accepted by Port and HostPort
+type portConfig struct {
+	min int
+}
+
+// NoPrivilegedPorts rejects the privileged ports (below 1024)
+func NoPrivilegedPorts() PortOption {
+	return func(c *portConfig) {
+		c.min = firstUnprivilegedPort
+	}
+}
+
+// newPortConfig creates a port configuration from options
+func newPortConfig(opts []PortOption) portConfig {
+	config := portConfig{min: 1}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// check returns an error if the port is out of the accepted range
+func (c portConfig) check(port float64) error {
+	if port != math.Trunc(port) || port < float64(c.min) || port > maxPort {
+		return newValidationError(CodePort, "min", strconv.Itoa(c.min))
+	}
+
+	return nil
+}
+
+// Port validator validates that a number is a port between 1 and 65535.
+// Use NoPrivilegedPorts to reject the ports below 1024.
+func Port(opts ...PortOption) Validator {
+	config := newPortConfig(opts)
+	return newNumberValidator(ConstraintInfo{Kind: "port", Params: map[string]interface{}{"min": config.min}}, config.check)
+}
+
+// HostPort validator validates that a string is a "host:port" address, e.g. "db.internal:5432" or "[::1]:8080".
+// The host is a hostname or an IP address and the port is checked like Port.
+func HostPort(opts ...PortOption) Validator {
+	config := newPortConfig(opts)
+	return newStringValidator(ConstraintInfo{Kind: "host_port", Params: map[string]interface{}{"min": config.min}}, func(str string) error {
+		host, portStr, err := net.SplitHostPort(str)
+		if err != nil || host == "" {
+			return newValidationError(CodeHostPort)
+		}
+
+		if net.ParseIP(host) == nil && !hostnameRegex.MatchString(host) {
+			return newValidationError(CodeHostPort)
+		}
+
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return newValidationError(CodeHostPort)
+		}
+
+		return config.check(float64(port))
+	})
+}
@@ -0,0 +1,47 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"port", Port(), 8080, ""},
+		{"port max", Port(), uint16(65535), ""},
+		{"port zero", Port(), 0, "must be a port between 1 and 65535"},
+		{"port too high", Port(), 65536, "must be a port between 1 and 65535"},
+		{"port fractional", Port(), 80.5, "must be a port between 1 and 65535"},
+		{"port privileged", Port(), 22, ""},
+		{"port privileged rejected", Port(NoPrivilegedPorts()), 22, "must be a port between 1024 and 65535"},
+		{"port unprivileged", Port(NoPrivilegedPorts()), 1024, ""},
+		{"port not a number", Port(), "80", "port validation requires numeric value and not a string type"},
+		{"host port", HostPort(), "db.internal:5432", ""},
+		{"host port ipv4", HostPort(), "10.0.0.1:80", ""},
+		{"host port ipv6", HostPort(), "[::1]:8080", ""},
+		{"host port empty", HostPort(), "", ""},
+		{"host port missing port", HostPort(), "db.internal", "must be a host:port address"},
+		{"host port missing host", HostPort(), ":8080", "must be a host:port address"},
+		{"host port bad host", HostPort(), "db_internal!:80", "must be a host:port address"},
+		{"host port named port", HostPort(), "db.internal:http", "must be a host:port address"},
+		{"host port out of range", HostPort(), "db.internal:70000", "must be a port between 1 and 65535"},
+		{"host port privileged", HostPort(NoPrivilegedPorts()), "db.internal:80", "must be a port between 1024 and 65535"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "address")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
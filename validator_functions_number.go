@@ -0,0 +1,76 @@
+package poxxy
+
+import (
+	"math"
+	"reflect"
+)
+
+// Percent validator validates that a number is a percentage between 0 and 100 (inclusive)
+func Percent() Validator {
+	return newNumberValidator(ConstraintInfo{Kind: "percent"}, func(n float64) error {
+		if !(n >= 0 && n <= 100) {
+			return newValidationError(CodePercent)
+		}
+		return nil
+	})
+}
+
+// Ratio validator validates that a number is a ratio between 0 and 1 (inclusive)
+func Ratio() Validator {
+	return newNumberValidator(ConstraintInfo{Kind: "ratio"}, func(n float64) error {
+		if !(n >= 0 && n <= 1) {
+			return newValidationError(CodeRatio)
+		}
+		return nil
+	})
+}
+
+// Finite validator validates that a number is neither NaN nor infinite
+func Finite() Validator {
+	return newNumberValidator(ConstraintInfo{Kind: "finite"}, func(n float64) error {
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return newValidationError(CodeFinite)
+		}
+		return nil
+	})
+}
+
+// WithRejectNonFinite creates a schema option rejecting NaN and ±Inf in every float field
+// (values, pointers, slices and arrays of floats), as if they had the Finite validator.
+// The check runs before the field validators, which are skipped for a non-finite value.
+// It applies to the sub-schemas of Struct, Pointer, Slice, Map and HTTPMap fields.
+func WithRejectNonFinite() SchemaOption {
+	return func(s *Schema) {
+		s.rejectNonFinite = true
+	}
+}
+
+// checkFinite returns an error if value is a non-finite float, or a slice or array containing one
+func checkFinite(value interface{}) error {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+			return newValidationError(CodeFinite)
+		}
+	case reflect.Slice, reflect.Array:
+		var errs ElementErrors
+		for i := 0; i < v.Len(); i++ {
+			if err := checkFinite(v.Index(i).Interface()); err != nil {
+				errs = append(errs, ElementError{Index: i, Error: err})
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+
+	return nil
+}
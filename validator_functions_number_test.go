@@ -0,0 +1,93 @@
+package poxxy
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentAndRatio(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"percent int", Percent(), 42, ""},
+		{"percent bounds", Percent(), 100.0, ""},
+		{"percent zero", Percent(), uint8(0), ""},
+		{"percent nil", Percent(), nil, ""},
+		{"percent too high", Percent(), 100.5, "must be a percentage between 0 and 100"},
+		{"percent negative", Percent(), -1, "must be a percentage between 0 and 100"},
+		{"percent nan", Percent(), math.NaN(), "must be a percentage between 0 and 100"},
+		{"ratio", Ratio(), 0.25, ""},
+		{"ratio one", Ratio(), 1, ""},
+		{"ratio too high", Ratio(), 1.01, "must be a ratio between 0 and 1"},
+		{"ratio negative", Ratio(), float32(-0.1), "must be a ratio between 0 and 1"},
+		{"ratio not a number", Ratio(), "0.5", "ratio validation requires numeric value and not a string type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "value")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFinite(t *testing.T) {
+	validator := Finite()
+	assert.NoError(t, validator.Validate(1.5, "value"))
+	assert.NoError(t, validator.Validate(42, "value"))
+	assert.NoError(t, validator.Validate(nil, "value"))
+	assert.EqualError(t, validator.Validate(math.NaN(), "value"), "must be a finite number")
+	assert.EqualError(t, validator.Validate(math.Inf(-1), "value"), "must be a finite number")
+	assert.EqualError(t, validator.Validate(float32(math.Inf(1)), "value"), "must be a finite number")
+}
+
+func TestWithRejectNonFinite(t *testing.T) {
+	var score float64
+	var weight *float64
+	var samples []float64
+	var count int
+	schema := NewSchema(
+		Value("score", &score, WithValidators(Min(0.0))),
+		Pointer("weight", &weight),
+		Slice("samples", &samples),
+		Value("count", &count),
+	)
+
+	data := map[string]interface{}{"score": "NaN", "weight": "+Inf", "samples": []interface{}{1.5, "-Inf"}, "count": 3}
+	require.NoError(t, schema.Apply(data))
+	assert.True(t, math.IsNaN(score))
+
+	err := schema.Apply(data, WithRejectNonFinite())
+	require.Error(t, err)
+	assert.Equal(t, Errors{
+		{Field: "score", Error: newValidationError(CodeFinite), Path: Path{"score"}, Code: CodeFinite},
+		{Field: "weight", Error: newValidationError(CodeFinite), Path: Path{"weight"}, Code: CodeFinite},
+		{Field: "samples", Error: ElementErrors{{Index: 1, Error: newValidationError(CodeFinite)}}, Path: Path{"samples"}},
+	}, err)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"score": 2.5, "weight": 1, "samples": []interface{}{0.1}}))
+
+	t.Run("sub-schemas", func(t *testing.T) {
+		type Point struct {
+			X float64
+		}
+
+		var point Point
+		schema := NewSchema(Struct("point", &point, WithSubSchema(func(s *Schema, p *Point) {
+			WithSchema(s, Value("x", &p.X))
+		})))
+
+		err := schema.Apply(map[string]interface{}{"point": map[string]interface{}{"x": "NaN"}}, WithRejectNonFinite())
+		assert.EqualError(t, err, "point: x: must be a finite number")
+	})
+}
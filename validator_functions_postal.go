@@ -0,0 +1,108 @@
+package poxxy
+
+import (
+	"regexp"
+	"strings"
+)
+
+// postalCodeFormats holds the postal code format of each country, indexed by ISO 3166-1 alpha-2 code
+var postalCodeFormats = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^\d{4}$`),
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"BE": regexp.MustCompile(`^\d{4}$`),
+	"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+	"CA": regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`),
+	"CH": regexp.MustCompile(`^\d{4}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"DK": regexp.MustCompile(`^\d{4}$`),
+	"ES": regexp.MustCompile(`^(?:0[1-9]|[1-4]\d|5[0-2])\d{3}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"GB": regexp.MustCompile(`^(?:[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}|GIR ?0AA)$`),
+	"IE": regexp.MustCompile(`^(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}$`),
+	"IN": regexp.MustCompile(`^\d{6}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+	"LU": regexp.MustCompile(`^(?:L-)?\d{4}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"NO": regexp.MustCompile(`^\d{4}$`),
+	"PL": regexp.MustCompile(`^\d{2}-\d{3}$`),
+	"PT": regexp.MustCompile(`^\d{4}-\d{3}$`),
+	"SE": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"US": regexp.MustCompile(`^\d{5}(?:-\d{4})?$`),
+}
+
+// genericPostalCodeFormat is used for the countries missing from postalCodeFormats
+var genericPostalCodeFormat = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,8}[A-Z0-9]$`)
+
+// checkPostalCode returns an error if the code doesn't match the format of the country.
+// The check is case-insensitive; unknown countries only get a loose alphanumeric check.
+func checkPostalCode(code, country string) error {
+	country = strings.ToUpper(country)
+	format, ok := postalCodeFormats[country]
+	if !ok {
+		format = genericPostalCodeFormat
+	}
+
+	if !format.MatchString(strings.ToUpper(code)) {
+		return newValidationError(CodePostalCode, "country", country)
+	}
+
+	return nil
+}
+
+// PostalCode validator validates that a string is a postal code of the given country (ISO 3166-1 alpha-2 code,
+// e.g. "FR" or "GB"). Countries without a known format only get a loose check (3 to 10 letters, digits,
+// spaces or hyphens). Use PostalCodeForCountry when the country is another field of the schema.
+func PostalCode(country string) Validator {
+	return newStringValidator(ConstraintInfo{Kind: "postal_code", Params: map[string]interface{}{"country": country}}, func(str string) error {
+		return checkPostalCode(str, country)
+	})
+}
+
+// PostalCodeForCountry creates a schema rule validating the postal code field against the country read from
+// another field of the same schema (see PostalCode). Both fields must be string or *string fields;
+// the rule is skipped when one of them is empty and its errors are attached to the postal code field.
+//
+//	poxxy.NewSchema(
+//		poxxy.Value("country", &country, poxxy.WithValidators(poxxy.Required())),
+//		poxxy.Value("zip", &zip),
+//		poxxy.PostalCodeForCountry("zip", "country"),
+//	)
+func PostalCodeForCountry(codeField, countryField string) Field {
+	return &ruleField{
+		name: codeField,
+		check: func(schema *Schema) error {
+			code, ok := stringFieldValue(schema, codeField)
+			if !ok {
+				return nil
+			}
+
+			country, ok := stringFieldValue(schema, countryField)
+			if !ok {
+				return nil
+			}
+
+			return checkPostalCode(code, country)
+		},
+	}
+}
+
+// stringFieldValue returns the non-empty string assigned to a string or *string field
+func stringFieldValue(schema *Schema, fieldName string) (string, bool) {
+	value, ok := schema.GetFieldValue(fieldName)
+	if !ok {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case *string:
+		if v == nil {
+			return "", false
+		}
+		return *v, *v != ""
+	default:
+		return "", false
+	}
+}
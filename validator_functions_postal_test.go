@@ -0,0 +1,59 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostalCode(t *testing.T) {
+	tests := []struct {
+		country string
+		value   interface{}
+		wantErr string
+	}{
+		{"FR", "75001", ""},
+		{"FR", "7500", "invalid postal code for FR"},
+		{"US", "94105-1234", ""},
+		{"us", "9410", "invalid postal code for US"},
+		{"GB", "SW1A 1AA", ""},
+		{"GB", "sw1a1aa", ""},
+		{"CA", "K1A 0B1", ""},
+		{"CA", "D1A 0B1", "invalid postal code for CA"},
+		{"NL", "1012 AB", ""},
+		{"ES", "53001", "invalid postal code for ES"},
+		{"PL", "00-950", ""},
+		{"ZZ", "AB-123", ""},
+		{"ZZ", "!", "invalid postal code for ZZ"},
+		{"FR", "", ""},
+	}
+
+	for _, tt := range tests {
+		err := PostalCode(tt.country).Validate(tt.value, "zip")
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, "%s %v", tt.country, tt.value)
+		} else {
+			assert.NoError(t, err, "%s %v", tt.country, tt.value)
+		}
+	}
+}
+
+func TestPostalCodeForCountry(t *testing.T) {
+	var country string
+	var zip *string
+	schema := NewSchema(
+		Value("country", &country),
+		Pointer("zip", &zip),
+		PostalCodeForCountry("zip", "country"),
+	)
+
+	assert.NoError(t, schema.Apply(map[string]interface{}{"country": "FR", "zip": "75001"}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"country": "GB", "zip": "SW1A 1AA"}))
+	assert.NoError(t, schema.Apply(map[string]interface{}{"zip": "anything"}))
+
+	err := schema.Apply(map[string]interface{}{"country": "GB", "zip": "75001"})
+	assert.EqualError(t, err, "zip: invalid postal code for GB")
+
+	schema = NewSchema(Value("zip", new(string), Rules("postal_code:FR")))
+	assert.EqualError(t, schema.Apply(map[string]interface{}{"zip": "ABC"}), "zip: invalid postal code for FR")
+}
@@ -0,0 +1,126 @@
+package poxxy
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ioValidator is implemented by validators performing I/O (database queries, DNS lookups, API calls).
+// A field runs them after its other validators, so cheap checks reject invalid values first.
+type ioValidator interface {
+	performsIO() bool
+}
+
+// isIOValidator reports whether a validator performs I/O
+func isIOValidator(validator Validator) bool {
+	v, ok := validator.(ioValidator)
+	return ok && v.performsIO()
+}
+
+// RemoteOption represents a configuration option for Remote
+type RemoteOption func(*remoteValidator)
+
+// RemoteTimeout limits the time the wrapped validator may take. When the timeout expires,
+// the value is rejected with a "timeout" error.
+func RemoteTimeout(timeout time.Duration) RemoteOption {
+	return func(v *remoteValidator) {
+		v.timeout = timeout
+	}
+}
+
+// remoteValidator marks a validator as performing I/O
+type remoteValidator struct {
+	validator Validator
+	timeout   time.Duration
+	msg       string
+}
+
+// Remote declares a validator performing I/O, e.g. a custom ValidatorFuncContext querying a database.
+// The field runs it after its other validators, once the value passed the cheap checks, with the context of
+// the schema (see ApplyContext) limited by RemoteTimeout:
+//
+//	poxxy.Remote(poxxy.NotExistsIn(repo.EmailTaken), poxxy.RemoteTimeout(200*time.Millisecond))
+//
+// ExistsIn, NotExistsIn, PublicURL, Throttled and VATNumber with WithVIESLookup validators are already run last.
+func Remote(validator Validator, opts ...RemoteOption) Validator {
+	v := &remoteValidator{validator: validator}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Validate validates a value using a background context
+func (v *remoteValidator) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext runs the wrapped validator within the timeout
+func (v *remoteValidator) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	return v.run(ctx, func(ctx context.Context) error {
+		if ctxValidator, ok := v.validator.(ContextValidator); ok {
+			return ctxValidator.ValidateContext(ctx, value, fieldName)
+		}
+		return v.validator.Validate(value, fieldName)
+	})
+}
+
+// validateWithSchema runs the wrapped validator within the timeout, giving it the presence of the field
+// in the input (see ExistsIn)
+func (v *remoteValidator) validateWithSchema(schema *Schema, value interface{}, fieldName string) error {
+	inner, ok := v.validator.(presenceValidator)
+	if !ok {
+		return v.ValidateContext(schema.Context(), value, fieldName)
+	}
+
+	present := schema != nil && schema.IsFieldPresent(fieldName)
+	return v.run(schema.Context(), func(ctx context.Context) error {
+		return inner.validatePresent(ctx, value, fieldName, present)
+	})
+}
+
+// run runs validate within the timeout
+func (v *remoteValidator) run(ctx context.Context, validate func(ctx context.Context) error) error {
+	parent := ctx
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+
+	err := validate(ctx)
+
+	// Only the timeout of the validator is a validation failure, the cancellation of the caller is not
+	if err != nil && parent.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = newValidationError(CodeTimeout)
+	}
+
+	if err != nil && v.msg != "" {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// WithMessage sets a custom error message for the wrapped validator failures
+func (v *remoteValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the wrapped validator
+func (v *remoteValidator) Describe() ConstraintInfo {
+	if describer, ok := v.validator.(Describer); ok {
+		return describer.Describe()
+	}
+
+	return ConstraintInfo{}
+}
+
+// performsIO implements ioValidator interface
+func (v *remoteValidator) performsIO() bool {
+	return true
+}
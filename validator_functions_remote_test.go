@@ -0,0 +1,76 @@
+package poxxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemote(t *testing.T) {
+	var calls []string
+	emailTaken := func(ctx context.Context, email string) (bool, error) {
+		calls = append(calls, email)
+		return email == "taken@example.com", nil
+	}
+
+	t.Run("runs after the cheap validators", func(t *testing.T) {
+		calls = nil
+		var email string
+		schema := NewSchema(Value("email", &email, WithValidators(
+			NotExistsIn(emailTaken),
+			Required(),
+			Email(),
+		)))
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"email": "not an email"}), "email: invalid email format")
+		assert.Empty(t, calls)
+
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"email": "taken@example.com"}), "email: value taken@example.com already exists")
+		assert.Equal(t, []string{"taken@example.com"}, calls)
+	})
+
+	t.Run("custom validator", func(t *testing.T) {
+		calls = nil
+		var email string
+		checked := ValidatorFuncContext(func(ctx context.Context, email string, fieldName string) error {
+			calls = append(calls, email)
+			return nil
+		})
+		schema := NewSchema(Value("email", &email, WithValidators(Remote(checked), Email())))
+
+		require.Error(t, schema.Apply(map[string]interface{}{"email": "nope"}))
+		assert.Empty(t, calls)
+		require.NoError(t, schema.Apply(map[string]interface{}{"email": "jane@example.com"}))
+		assert.Equal(t, []string{"jane@example.com"}, calls)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		slow := ValidatorFuncContext(func(ctx context.Context, email string, fieldName string) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		var email string
+		schema := NewSchema(Value("email", &email, WithValidators(Remote(slow, RemoteTimeout(10*time.Millisecond)))))
+
+		err := schema.Apply(map[string]interface{}{"email": "jane@example.com"})
+		require.Error(t, err)
+		assert.Equal(t, CodeTimeout, err.(Errors)[0].Code)
+		assert.EqualError(t, err, "email: validation timed out")
+
+		// The cancellation of the caller is not a validation failure
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, Remote(slow).(ContextValidator).ValidateContext(ctx, "jane@example.com", "email"), context.Canceled)
+	})
+
+	t.Run("custom message and description", func(t *testing.T) {
+		validator := Remote(NotExistsIn(emailTaken), RemoteTimeout(time.Second)).WithMessage("email already registered")
+
+		assert.EqualError(t, validator.Validate("taken@example.com", "email"), "email already registered")
+		assert.Equal(t, "not_exists_in", validator.(Describer).Describe().Kind)
+	})
+}
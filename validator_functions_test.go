@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -300,6 +301,60 @@ func TestMax(t *testing.T) {
 	})
 }
 
+// version is a comparable type used to test Min/Max with a Compare method
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(other version) int {
+	if v.major != other.major {
+		return v.major - other.major
+	}
+	return v.minor - other.minor
+}
+
+func TestMinMax_Comparable(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("time within bounds", func(t *testing.T) {
+		value := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		assert.NoError(t, Min(start).Validate(value, "date"))
+		assert.NoError(t, Max(end).Validate(value, "date"))
+		assert.NoError(t, Min(start).Validate(start, "date"))
+		assert.NoError(t, Max(end).Validate(end, "date"))
+	})
+
+	t.Run("time before min", func(t *testing.T) {
+		err := Min(start).Validate(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), "date")
+		assert.EqualError(t, err, "value must be at least 2024-01-01T00:00:00Z")
+	})
+
+	t.Run("time after max", func(t *testing.T) {
+		err := Max(end).Validate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "date")
+		assert.EqualError(t, err, "value must be at most 2024-12-31T00:00:00Z")
+	})
+
+	t.Run("type with Compare method", func(t *testing.T) {
+		assert.NoError(t, Min(version{1, 2}).Validate(version{1, 3}, "version"))
+		assert.EqualError(t, Min(version{1, 2}).Validate(version{1, 1}, "version"), "value must be at least {1 2}")
+		assert.EqualError(t, Max(version{2, 0}).Validate(version{2, 1}, "version"), "value must be at most {2 0}")
+	})
+
+	t.Run("in schema context", func(t *testing.T) {
+		var date time.Time
+		schema := NewSchema(
+			Convert("date", &date, func(s string) (*time.Time, error) {
+				d, err := time.Parse("2006-01-02", s)
+				return &d, err
+			}, WithValidators(Min(start), Max(end))),
+		)
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"date": "2024-03-01"}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"date": "2025-03-01"}), "date: value must be at most 2024-12-31T00:00:00Z")
+	})
+}
+
 func TestMinLength(t *testing.T) {
 	validator := MinLength(3)
 
@@ -565,6 +620,49 @@ func TestEach(t *testing.T) {
 	})
 }
 
+func TestEachAll(t *testing.T) {
+	elementValidator := EachAll(MinLength(2), MaxLength(5))
+
+	t.Run("valid slice", func(t *testing.T) {
+		err := elementValidator.Validate([]string{"hello", "world"}, "items")
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports every failing element", func(t *testing.T) {
+		err := elementValidator.Validate([]string{"a", "hello", "toolong", "b"}, "items")
+		assert.Error(t, err)
+
+		elementErrors, ok := err.(ElementErrors)
+		assert.True(t, ok)
+		assert.Len(t, elementErrors, 3)
+		assert.Equal(t, 0, elementErrors[0].Index)
+		assert.Equal(t, 2, elementErrors[1].Index)
+		assert.Equal(t, 3, elementErrors[2].Index)
+		assert.Equal(t, "element 0: must be at least 2 characters long; element 2: must be at most 5 characters long; element 3: must be at least 2 characters long", err.Error())
+	})
+
+	t.Run("in schema context", func(t *testing.T) {
+		var tags []string
+		schema := NewSchema(
+			Slice("tags", &tags, WithValidators(EachAll(MinLength(2)))),
+		)
+
+		err := schema.Apply(map[string]interface{}{"tags": []interface{}{"go", "x", "y"}})
+		assert.Error(t, err)
+		assert.Equal(t, "tags: element 1: must be at least 2 characters long; element 2: must be at least 2 characters long", err.Error())
+	})
+
+	t.Run("non-slice input", func(t *testing.T) {
+		err := elementValidator.Validate("not a slice", "items")
+		assert.Error(t, err)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		assert.Equal(t, "each_all", elementValidator.(Describer).Describe().Kind)
+		assert.Equal(t, "each", Each(MinLength(2)).(Describer).Describe().Kind)
+	})
+}
+
 func TestUnique(t *testing.T) {
 	validator := Unique()
 
@@ -727,6 +825,8 @@ func TestNotEmpty(t *testing.T) {
 		{"false bool", false},
 		{"non-empty slice", []string{"item"}},
 		{"non-empty map", map[string]string{"key": "value"}},
+		{"non-zero time", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"non-nil pointer", new(int)},
 	}
 
 	for _, tc := range testCases {
@@ -748,16 +848,80 @@ func TestNotEmpty(t *testing.T) {
 		// {"zero float", 0.0},
 		{"empty slice", []string{}},
 		{"empty map", map[string]string{}},
+		{"zero time", time.Time{}},
+		{"zero struct", struct{ Name string }{}},
+		{"nil pointer", (*int)(nil)},
 	}
 
 	for _, tc := range failCases {
 		t.Run(tc.name+" should fail", func(t *testing.T) {
 			err := validator.Validate(tc.value, "testField")
-			assert.Error(t, err)
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				assert.Equal(t, CodeNotEmpty, validationErr.Code)
+			}
 		})
 	}
 }
 
+func TestNotBlank(t *testing.T) {
+	validator := NotBlank()
+	text := "hello"
+	blank := " \t\n"
+
+	assert.NoError(t, validator.Validate("hello", "testField"))
+	assert.NoError(t, validator.Validate("  hello  ", "testField"))
+	assert.NoError(t, validator.Validate(&text, "testField"))
+
+	assert.EqualError(t, validator.Validate("", "testField"), "value cannot be blank")
+	assert.EqualError(t, validator.Validate("   ", "testField"), "value cannot be blank")
+	assert.EqualError(t, validator.Validate("\u00a0\u3000", "testField"), "value cannot be blank")
+	assert.EqualError(t, validator.Validate(&blank, "testField"), "value cannot be blank")
+	assert.EqualError(t, validator.Validate(nil, "testField"), "field is required")
+	assert.EqualError(t, validator.Validate((*string)(nil), "testField"), "field is required")
+	assert.EqualError(t, validator.Validate(42, "testField"), "not_blank validation requires string value and not a int type")
+
+	t.Run("in a schema", func(t *testing.T) {
+		var name string
+		schema := NewSchema(Value("name", &name, WithValidators(Required(), NotBlank())))
+
+		assert.NoError(t, schema.Apply(map[string]interface{}{"name": "John"}))
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"name": "   "}), "name: value cannot be blank")
+	})
+}
+
+func TestRequired_ZeroTime(t *testing.T) {
+	parseDate := func(s string) (*time.Time, error) {
+		if s == "zero" {
+			return &time.Time{}, nil
+		}
+		d, err := time.Parse("2006-01-02", s)
+		return &d, err
+	}
+
+	t.Run("zero time should fail", func(t *testing.T) {
+		var date time.Time
+		schema := NewSchema(
+			Convert("date", &date, parseDate, WithValidators(Required())),
+		)
+
+		err := schema.Apply(map[string]interface{}{"date": "zero"})
+		assert.Error(t, err)
+		assert.Equal(t, "date: value cannot be empty", err.Error())
+	})
+
+	t.Run("valid time should pass", func(t *testing.T) {
+		var date time.Time
+		schema := NewSchema(
+			Convert("date", &date, parseDate, WithValidators(Required())),
+		)
+
+		err := schema.Apply(map[string]interface{}{"date": "2024-05-01"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2024, date.Year())
+	})
+}
+
 func TestValidatorWithMessage(t *testing.T) {
 	t.Run("Required custom error message", func(t *testing.T) {
 		var value string
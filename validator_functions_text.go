@@ -0,0 +1,129 @@
+package poxxy
+
+import (
+	"strconv"
+	"unicode"
+)
+
+// emojiRanges holds the code point ranges of characters displayed as emoji by default.
+// Other symbols (e.g. ❤ or ⬅) are displayed as emoji when followed by the variation selector U+FE0F.
+var emojiRanges = [][2]rune{
+	{0x231A, 0x231B},   // watch, hourglass
+	{0x23E9, 0x23EC},   // media controls
+	{0x23F0, 0x23F0},   // alarm clock
+	{0x23F3, 0x23F3},   // hourglass with flowing sand
+	{0x25FD, 0x25FE},   // squares
+	{0x2614, 0x2615},   // umbrella, hot beverage
+	{0x2648, 0x2653},   // zodiac
+	{0x267F, 0x267F},   // wheelchair
+	{0x2693, 0x2693},   // anchor
+	{0x26A1, 0x26A1},   // high voltage
+	{0x26AA, 0x26AB},   // circles
+	{0x26BD, 0x26BE},   // balls
+	{0x26C4, 0x26C5},   // snowman, sun behind cloud
+	{0x26CE, 0x26CE},   // ophiuchus
+	{0x26D4, 0x26D4},   // no entry
+	{0x26EA, 0x26EA},   // church
+	{0x26F2, 0x26F3},   // fountain, golf
+	{0x26F5, 0x26F5},   // sailboat
+	{0x26FA, 0x26FA},   // tent
+	{0x26FD, 0x26FD},   // fuel pump
+	{0x2705, 0x2705},   // check mark button
+	{0x270A, 0x270B},   // raised fist, raised hand
+	{0x2728, 0x2728},   // sparkles
+	{0x274C, 0x274C},   // cross mark
+	{0x274E, 0x274E},   // cross mark button
+	{0x2753, 0x2755},   // question and exclamation marks
+	{0x2757, 0x2757},   // exclamation mark
+	{0x2795, 0x2797},   // plus, minus, divide
+	{0x27B0, 0x27B0},   // curly loop
+	{0x27BF, 0x27BF},   // double curly loop
+	{0x2B1B, 0x2B1C},   // large squares
+	{0x2B50, 0x2B50},   // star
+	{0x2B55, 0x2B55},   // circle
+	{0x1F000, 0x1FAFF}, // pictographs, emoticons, transport, flags, ...
+}
+
+// isEmoji reports whether a rune is displayed as an emoji by default
+func isEmoji(r rune) bool {
+	for _, rng := range emojiRanges {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// countEmoji returns the number of emoji in a string. Sequences displayed as a single emoji
+// (ZWJ sequences like 👨‍👩‍👧, skin tones, flags made of two regional indicators, keycaps) count as one.
+func countEmoji(str string) int {
+	count := 0
+	joined := false      // the next character is joined to the previous emoji by a zero width joiner
+	lastEmoji := false   // the last character is part of an emoji
+	pendingFlag := false // the last character is the first regional indicator of a flag
+	for _, r := range str {
+		switch {
+		case r == 0x200D: // zero width joiner
+			joined = lastEmoji
+		case r == 0xFE0F: // variation selector turning the previous symbol into an emoji
+			if !lastEmoji {
+				count++
+				lastEmoji = true
+			}
+		case r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+			// skin tone modifiers and tags extend the previous emoji
+		case r >= 0x1F1E6 && r <= 0x1F1FF: // regional indicators, paired into flags
+			if !pendingFlag && !joined {
+				count++
+			}
+			pendingFlag = !pendingFlag
+			lastEmoji = true
+			joined = false
+		default:
+			lastEmoji = isEmoji(r)
+			if lastEmoji && !joined {
+				count++
+			}
+			pendingFlag = false
+			joined = false
+		}
+	}
+
+	return count
+}
+
+// Printable validator validates that a string only contains printable characters: letters, marks, numbers,
+// punctuation, symbols and the ASCII space. Control characters, other spaces (tabs, newlines, no-break spaces)
+// and invisible format characters (zero-width spaces, bidirectional overrides) are rejected.
+func Printable() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "printable"}, func(str string) error {
+		for _, r := range str {
+			if !unicode.IsPrint(r) {
+				return newValidationError(CodePrintable)
+			}
+		}
+		return nil
+	})
+}
+
+// NoEmoji validator validates that a string doesn't contain any emoji
+func NoEmoji() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "no_emoji"}, func(str string) error {
+		if countEmoji(str) > 0 {
+			return newValidationError(CodeNoEmoji)
+		}
+		return nil
+	})
+}
+
+// MaxEmoji validator validates that a string contains at most max emoji.
+// Emoji sequences displayed as a single glyph (families, skin tones, flags) count as one.
+func MaxEmoji(max int) Validator {
+	return newStringValidator(ConstraintInfo{Kind: "max_emoji", Params: map[string]interface{}{"max": max}}, func(str string) error {
+		if countEmoji(str) > max {
+			return newValidationError(CodeMaxEmoji, "max", strconv.Itoa(max))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		value     interface{}
+		wantErr   string
+	}{
+		{"printable", Printable(), "Jean-Loup Ñúñez 42!", ""},
+		{"printable emoji", Printable(), "john 🎉", ""},
+		{"printable zero width space", Printable(), "jo​hn", "must only contain printable characters"},
+		{"printable bidi override", Printable(), "john‮gpj.exe", "must only contain printable characters"},
+		{"printable newline", Printable(), "john\ndoe", "must only contain printable characters"},
+		{"printable no-break space", Printable(), "john doe", "must only contain printable characters"},
+		{"no emoji", NoEmoji(), "Café ★", ""},
+		{"no emoji pictograph", NoEmoji(), "john 🎉", "must not contain emoji"},
+		{"no emoji dingbat", NoEmoji(), "I ❤️ Go", "must not contain emoji"},
+		{"max emoji", MaxEmoji(1), "john 🎉", ""},
+		{"max emoji exceeded", MaxEmoji(1), "🎉 john 🎉", "must contain at most 1 emoji"},
+		{"max emoji family", MaxEmoji(1), "👨‍👩‍👧", ""},
+		{"max emoji skin tone", MaxEmoji(1), "👍🏽", ""},
+		{"max emoji flags", MaxEmoji(1), "🇫🇷🇩🇪", "must contain at most 1 emoji"},
+		{"max emoji flag", MaxEmoji(1), "🇫🇷", ""},
+		{"no emoji not a string", NoEmoji(), 42, "no_emoji validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.value, "username")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package poxxy
+
+import "context"
+
+// Limiter decides whether an operation may run now.
+// It is satisfied by *rate.Limiter from golang.org/x/time/rate.
+type Limiter interface {
+	Allow() bool
+}
+
+// ThrottleOption represents a configuration option for Throttled
+type ThrottleOption func(*throttledValidator)
+
+// PassWhenThrottled makes a throttled validator accept the value when the limiter denies the validation.
+// By default the value is rejected with a "throttled" error.
+func PassWhenThrottled() ThrottleOption {
+	return func(v *throttledValidator) {
+		v.passWhenThrottled = true
+	}
+}
+
+// throttledValidator runs a validator only when its limiter allows it
+type throttledValidator struct {
+	validator         Validator
+	limiter           Limiter
+	passWhenThrottled bool
+	msg               string
+}
+
+// Throttled wraps an expensive validator (MX lookups, external API checks, ...) so it only runs
+// when the limiter allows it. The limiter is shared by every Apply of the schema, which makes it
+// suitable to rate-limit validations across requests.
+// When throttled, the value is rejected unless PassWhenThrottled is used.
+func Throttled(validator Validator, limiter Limiter, opts ...ThrottleOption) Validator {
+	v := &throttledValidator{
+		validator: validator,
+		limiter:   limiter,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Validate validates a value using a background context
+func (v *throttledValidator) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext runs the wrapped validator if the limiter allows it
+func (v *throttledValidator) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	if !v.limiter.Allow() {
+		if v.passWhenThrottled {
+			return nil
+		}
+		return newValidationError(CodeThrottled)
+	}
+
+	var err error
+	if ctxValidator, ok := v.validator.(ContextValidator); ok {
+		err = ctxValidator.ValidateContext(ctx, value, fieldName)
+	} else {
+		err = v.validator.Validate(value, fieldName)
+	}
+
+	if err != nil && v.msg != "" {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// WithMessage sets a custom error message for the wrapped validator failures
+func (v *throttledValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the wrapped validator
+func (v *throttledValidator) Describe() ConstraintInfo {
+	if describer, ok := v.validator.(Describer); ok {
+		return describer.Describe()
+	}
+
+	return ConstraintInfo{}
+}
+
+// performsIO implements ioValidator interface
+func (v *throttledValidator) performsIO() bool {
+	return true
+}
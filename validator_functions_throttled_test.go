@@ -0,0 +1,40 @@
+package poxxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countLimiter allows a fixed number of operations
+type countLimiter struct {
+	remaining int
+}
+
+func (l *countLimiter) Allow() bool {
+	if l.remaining == 0 {
+		return false
+	}
+	l.remaining--
+	return true
+}
+
+func TestThrottled(t *testing.T) {
+	t.Run("runs the validator while allowed", func(t *testing.T) {
+		validator := Throttled(Email(), &countLimiter{remaining: 2})
+
+		assert.NoError(t, validator.Validate("john@example.com", "email"))
+		assert.EqualError(t, validator.Validate("invalid", "email"), "invalid email format")
+		assert.EqualError(t, validator.Validate("john@example.com", "email"), "validation temporarily unavailable, please retry later")
+	})
+
+	t.Run("pass when throttled", func(t *testing.T) {
+		validator := Throttled(Email(), &countLimiter{}, PassWhenThrottled())
+		assert.NoError(t, validator.Validate("invalid", "email"))
+	})
+
+	t.Run("describes the wrapped validator", func(t *testing.T) {
+		validator := Throttled(Email(), &countLimiter{})
+		assert.Equal(t, ConstraintInfo{Kind: "email"}, validator.(Describer).Describe())
+	})
+}
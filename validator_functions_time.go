@@ -0,0 +1,21 @@
+package poxxy
+
+import (
+	"time"
+)
+
+// TimeZone validator validates that a string is an IANA time zone name (e.g. "Europe/Paris", "UTC")
+func TimeZone() Validator {
+	return newStringValidator(ConstraintInfo{Kind: "timezone"}, func(str string) error {
+		// "Local" is accepted by time.LoadLocation but is not an IANA name
+		if str == "Local" {
+			return newValidationError(CodeTimeZone)
+		}
+
+		if _, err := time.LoadLocation(str); err != nil {
+			return newValidationError(CodeTimeZone)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package poxxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{"iana name", "Europe/Paris", false},
+		{"utc", "UTC", false},
+		{"empty", "", false},
+		{"nil", nil, false},
+		{"unknown", "Mars/Olympus", true},
+		{"local", "Local", true},
+		{"offset", "+02:00", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TimeZone().Validate(tt.value, "tz")
+			if tt.wantErr {
+				assert.EqualError(t, err, "invalid time zone")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("non string", func(t *testing.T) {
+		assert.Error(t, TimeZone().Validate(42, "tz"))
+	})
+}
+
+func TestInLocation(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	var at time.Time
+	schema := NewSchema(
+		Value("at", &at, WithTransformers(InLocation(paris))),
+	)
+
+	input := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, schema.Apply(map[string]interface{}{"at": input}))
+
+	assert.Equal(t, paris, at.Location())
+	assert.Equal(t, 12, at.Hour())
+	assert.True(t, input.Equal(at))
+}
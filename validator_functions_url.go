@@ -0,0 +1,327 @@
+package poxxy
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// urlPolicy holds the checks applied to the URLs of URL fields
+type urlPolicy struct {
+	schemes          []string // Allowed schemes, http and https when empty
+	denyPrivateHosts bool
+}
+
+// check parses str, returning an error if it is not an absolute URL allowed by the policy
+func (p urlPolicy) check(str string) (*url.URL, error) {
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" || u.Hostname() == "" {
+		return nil, newValidationError(CodeURL)
+	}
+
+	schemes := p.schemes
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+	if !containsFold(schemes, u.Scheme) {
+		return nil, newValidationError(CodeURLScheme, "schemes", strings.Join(schemes, ", "))
+	}
+
+	if p.denyPrivateHosts && isPrivateHost(u.Hostname()) {
+		return nil, newValidationError(CodePrivateHost)
+	}
+
+	return u, nil
+}
+
+// describe returns the constraint metadata of the policy
+func (p urlPolicy) describe() ConstraintInfo {
+	params := map[string]interface{}{}
+	if len(p.schemes) > 0 {
+		params["schemes"] = p.schemes
+	}
+	if p.denyPrivateHosts {
+		params["deny_private_hosts"] = true
+	}
+
+	return ConstraintInfo{Kind: "url", Params: params}
+}
+
+// containsFold reports whether values contains str, ignoring case
+func containsFold(values []string, str string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, str) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ranges not covered by the netip.Addr methods: the carrier-grade NAT range (RFC 6598), and "this network"
+// (RFC 1122), 0.0.0.1 reaching the local machine on Linux
+var (
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+	thisNetwork        = netip.MustParsePrefix("0.0.0.0/8")
+)
+
+// IPv6 ranges embedding an IPv4 address: NAT64 (RFC 6052), 6to4 (RFC 3056) and the deprecated
+// IPv4-compatible addresses (RFC 4291)
+var (
+	nat64Prefix          = netip.MustParsePrefix("64:ff9b::/96")
+	sixToFourPrefix      = netip.MustParsePrefix("2002::/16")
+	ipv4CompatiblePrefix = netip.MustParsePrefix("::/96")
+)
+
+// isPrivateHost reports whether host designates the local machine or a private network:
+// localhost and the .localhost, .local and .internal names, loopback, private, link-local,
+// unspecified, "this network" and multicast addresses (IPv4 addresses embedded in IPv6 ones included:
+// IPv4-mapped, IPv4-compatible, NAT64 and 6to4), and the numeric IPv4 forms resolved by some clients
+// (e.g. "2130706433" or "0x7f.1" for 127.0.0.1).
+//
+// The host is not resolved: a public name pointing to a private address passes, so the HTTP client
+// making the requests must also refuse private addresses when connecting.
+func isPrivateHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") ||
+		strings.HasSuffix(host, ".local") || strings.HasSuffix(host, ".internal") {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		// Non-standard IPv4 forms can't be checked reliably, they are rejected
+		return isNumericHost(host)
+	}
+
+	addr = addr.Unmap()
+	if isPrivateAddr(addr) {
+		return true
+	}
+
+	embedded, ok := embeddedIPv4(addr)
+	return ok && isPrivateAddr(embedded)
+}
+
+// isPrivateAddr reports whether addr is a loopback, private, link-local, unspecified, "this network" or
+// multicast address
+func isPrivateAddr(addr netip.Addr) bool {
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr) || thisNetwork.Contains(addr)
+}
+
+// embeddedIPv4 returns the IPv4 address embedded in a NAT64, 6to4 or IPv4-compatible IPv6 address
+func embeddedIPv4(addr netip.Addr) (netip.Addr, bool) {
+	bytes := addr.As16()
+	switch {
+	case nat64Prefix.Contains(addr), ipv4CompatiblePrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[12:16])), true
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[2:6])), true
+	default:
+		return netip.Addr{}, false
+	}
+}
+
+// isNumericHost reports whether every label of host is a decimal, octal or hexadecimal number,
+// the forms of IPv4 addresses other than dotted decimal accepted by some resolvers
+func isNumericHost(host string) bool {
+	for _, label := range strings.Split(host, ".") {
+		digits, base := label, "0123456789"
+		if strings.HasPrefix(label, "0x") {
+			digits, base = label[2:], "0123456789abcdef"
+		}
+		if digits == "" || strings.Trim(digits, base) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// URLHostIn validator validates that a value is an absolute URL whose host is one of the given hosts,
+// e.g. to restrict linked resources to approved domains. A pattern starting with "*." matches the
+// subdomains of the domain at any depth, but not the domain itself: "*.trusted.com" matches
+// "img.trusted.com" and "a.b.trusted.com". Hosts are compared case-insensitively, ports are ignored.
+// The scheme must be http or https.
+func URLHostIn(hosts ...string) Validator {
+	patterns := make([]string, len(hosts))
+	for i, host := range hosts {
+		patterns[i] = strings.TrimSuffix(strings.ToLower(host), ".")
+	}
+
+	return newStringValidator(ConstraintInfo{Kind: "url_host_in", Params: map[string]interface{}{"hosts": hosts}}, func(str string) error {
+		// Only http and https URLs are allowed, e.g. not javascript://cdn.example.com
+		u, err := urlPolicy{}.check(str)
+		if err != nil {
+			return err
+		}
+
+		host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+		for _, pattern := range patterns {
+			if host == pattern || (strings.HasPrefix(pattern, "*.") && strings.HasSuffix(host, pattern[1:])) {
+				return nil
+			}
+		}
+
+		return newValidationError(CodeURLHostIn)
+	})
+}
+
+// Resolver resolves host names to IP addresses. It is satisfied by *net.Resolver.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// PublicURLOption represents a configuration option for PublicURL
+type PublicURLOption func(*publicURLValidator)
+
+// WithResolver sets the resolver used by PublicURL (net.DefaultResolver by default)
+func WithResolver(resolver Resolver) PublicURLOption {
+	return func(v *publicURLValidator) {
+		v.resolver = resolver
+	}
+}
+
+// WithResolveTimeout limits the time spent resolving the host (2 seconds by default)
+func WithResolveTimeout(timeout time.Duration) PublicURLOption {
+	return func(v *publicURLValidator) {
+		v.timeout = timeout
+	}
+}
+
+// WithoutResolve makes PublicURL only check the host as written, without resolving it
+func WithoutResolve() PublicURLOption {
+	return func(v *publicURLValidator) {
+		v.resolver = nil
+	}
+}
+
+// publicURLValidator validates that a URL points to a public host
+type publicURLValidator struct {
+	policy   urlPolicy
+	resolver Resolver
+	timeout  time.Duration
+	msg      string
+}
+
+// PublicURL validator validates that a value is an http or https URL whose host is public, for endpoints
+// registering URLs the server will call (webhooks, avatars to download, ...). Hosts written as private
+// addresses or names (localhost, 10.0.0.1, ...) are rejected, then the host is resolved and rejected if
+// any of its addresses is private (loopback, RFC 1918, link-local, ...).
+//
+// The resolution uses the context of the schema (see ApplyContext). It doesn't protect against a DNS
+// record changed after validation: the HTTP client must also refuse private addresses when connecting.
+func PublicURL(opts ...PublicURLOption) Validator {
+	v := &publicURLValidator{
+		policy:   urlPolicy{denyPrivateHosts: true},
+		resolver: net.DefaultResolver,
+		timeout:  2 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Validate validates a value using a background context
+func (v *publicURLValidator) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext validates that the URL and the addresses of its host are public
+func (v *publicURLValidator) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	err := v.validate(ctx, value)
+	if err != nil && v.msg != "" && ctx.Err() == nil {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// validate checks the URL, then resolves its host
+func (v *publicURLValidator) validate(ctx context.Context, value interface{}) error {
+	if valuer, ok := value.(driver.Valuer); ok {
+		vv, err := valuer.Value()
+		if err != nil {
+			return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+		}
+		value = vv
+	}
+
+	// Use the Required() validator to enforce presence
+	if value == nil {
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("public_url validation requires string value and not a %T type", value)
+	}
+	if str == "" {
+		return nil
+	}
+
+	u, err := v.policy.check(str)
+	if err != nil {
+		return err
+	}
+
+	host := u.Hostname()
+	if v.resolver == nil {
+		return nil
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		// IP literals were checked by the policy
+		return nil
+	}
+
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+
+	addrs, err := v.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		// The caller canceled the validation, this is not a property of the URL
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ctx.Err()
+		}
+		return newValidationError(CodeUnresolvableHost)
+	}
+
+	for _, addr := range addrs {
+		if isPrivateHost(addr.Unmap().String()) {
+			return newValidationError(CodePrivateHost)
+		}
+	}
+
+	return nil
+}
+
+// WithMessage sets a custom error message for the validator
+func (v *publicURLValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the validator
+func (v *publicURLValidator) Describe() ConstraintInfo {
+	return ConstraintInfo{Kind: "public_url"}
+}
+
+// performsIO implements ioValidator interface
+func (v *publicURLValidator) performsIO() bool {
+	return v.resolver != nil
+}
@@ -0,0 +1,130 @@
+package poxxy
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPrivateHost(t *testing.T) {
+	for _, host := range []string{
+		"localhost", "LOCALHOST.", "api.localhost", "printer.local", "metadata.google.internal",
+		"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0",
+		"::1", "fe80::1", "fc00::1", "::ffff:127.0.0.1", "::", "224.0.0.1", "0.0.0.1",
+		"64:ff9b::7f00:1", "2002:7f00:1::", "2002:a9fe:a9fe::1", "::127.0.0.1",
+		"2130706433", "0x7f.1", "0177.0.0.1",
+	} {
+		assert.True(t, isPrivateHost(host), host)
+	}
+
+	for _, host := range []string{
+		"example.com", "8.8.8.8", "2001:4860:4860::8888", "123.example.com", "0xdeadbeef.com",
+		"64:ff9b::808:808", "2002:808:808::",
+	} {
+		assert.False(t, isPrivateHost(host), host)
+	}
+}
+
+type fakeResolver map[string][]netip.Addr
+
+func (r fakeResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestPublicURL(t *testing.T) {
+	resolver := fakeResolver{
+		"hooks.example.com":   {netip.MustParseAddr("93.184.216.34")},
+		"rebind.example.com":  {netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")},
+		"mapped.example.com":  {netip.MustParseAddr("::ffff:127.0.0.1")},
+		"metadata.example.io": {netip.MustParseAddr("169.254.169.254")},
+	}
+	validator := PublicURL(WithResolver(resolver))
+
+	tests := []struct {
+		value   interface{}
+		wantErr string
+	}{
+		{"https://hooks.example.com/events", ""},
+		{"https://93.184.216.34/events", ""},
+		{"", ""},
+		{nil, ""},
+		{"https://rebind.example.com", "URL must not point to a private host"},
+		{"https://mapped.example.com", "URL must not point to a private host"},
+		{"http://metadata.example.io/latest", "URL must not point to a private host"},
+		{"http://127.0.0.1:8080", "URL must not point to a private host"},
+		{"http://[::1]/", "URL must not point to a private host"},
+		{"http://0.0.0.1", "URL must not point to a private host"},
+		{"http://[64:ff9b::7f00:1]", "URL must not point to a private host"},
+		{"http://[2002:7f00:1::]", "URL must not point to a private host"},
+		{"http://[::127.0.0.1]", "URL must not point to a private host"},
+		{"https://unknown.example.com", "URL host cannot be resolved"},
+		{"ftp://hooks.example.com", "URL scheme must be one of http, https"},
+		{"hooks.example.com", "invalid URL format"},
+		{42, "public_url validation requires string value and not a int type"},
+	}
+
+	for _, tt := range tests {
+		err := validator.Validate(tt.value, "url")
+		if tt.wantErr == "" {
+			assert.NoError(t, err, tt.value)
+		} else {
+			assert.EqualError(t, err, tt.wantErr, tt.value)
+		}
+	}
+
+	t.Run("without resolve", func(t *testing.T) {
+		validator := PublicURL(WithoutResolve())
+		assert.NoError(t, validator.Validate("https://rebind.example.com", "url"))
+		assert.Error(t, validator.Validate("https://localhost", "url"))
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		var callback string
+		schema := NewSchema(Value("callback", &callback, WithValidators(validator.WithMessage("invalid callback"))))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, schema.ApplyContext(ctx, map[string]interface{}{"callback": "https://hooks.example.com"}), context.Canceled)
+		assert.EqualError(t, schema.Apply(map[string]interface{}{"callback": "https://rebind.example.com"}), "callback: invalid callback")
+	})
+}
+
+func TestURLHostIn(t *testing.T) {
+	validator := URLHostIn("cdn.example.com", "*.Trusted.com")
+
+	for _, value := range []string{
+		"https://cdn.example.com/logo.png",
+		"https://CDN.example.com:8443/logo.png",
+		"https://cdn.example.com./logo.png",
+		"https://img.trusted.com/a.jpg",
+		"https://a.b.trusted.com/a.jpg",
+		"",
+	} {
+		assert.NoError(t, validator.Validate(value, "url"), value)
+	}
+
+	for _, value := range []string{
+		"https://trusted.com/a.jpg",
+		"https://eviltrusted.com/a.jpg",
+		"https://cdn.example.com.evil.com/a.jpg",
+		"https://cdn.example.com@evil.com/a.jpg",
+		"https://example.com/cdn.example.com",
+	} {
+		assert.EqualError(t, validator.Validate(value, "url"), "URL host is not allowed", value)
+	}
+
+	assert.EqualError(t, validator.Validate("cdn.example.com/logo.png", "url"), "invalid URL format")
+	assert.EqualError(t, validator.Validate("javascript://cdn.example.com/%0aalert(1)", "url"), "URL scheme must be one of http, https")
+	assert.EqualError(t, validator.Validate("file://cdn.example.com/etc/passwd", "url"), "URL scheme must be one of http, https")
+	assert.Equal(t, `url_host_in(hosts=[cdn.example.com *.Trusted.com])`, formatConstraint(validator.(Describer).Describe()))
+}
@@ -0,0 +1,154 @@
+package poxxy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// vatFormats holds the format of the VAT numbers of each country, without the country prefix.
+// Greece uses the EL prefix and Northern Ireland the XI prefix.
+var vatFormats = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^U\d{8}$`),
+	"BE": regexp.MustCompile(`^[01]\d{9}$`),
+	"BG": regexp.MustCompile(`^\d{9,10}$`),
+	"CY": regexp.MustCompile(`^\d{8}[A-Z]$`),
+	"CZ": regexp.MustCompile(`^\d{8,10}$`),
+	"DE": regexp.MustCompile(`^\d{9}$`),
+	"DK": regexp.MustCompile(`^\d{8}$`),
+	"EE": regexp.MustCompile(`^\d{9}$`),
+	"EL": regexp.MustCompile(`^\d{9}$`),
+	"ES": regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`),
+	"FI": regexp.MustCompile(`^\d{8}$`),
+	"FR": regexp.MustCompile(`^[A-HJ-NP-Z0-9]{2}\d{9}$`),
+	"GB": regexp.MustCompile(`^(?:\d{9}|\d{12}|GD\d{3}|HA\d{3})$`),
+	"HR": regexp.MustCompile(`^\d{11}$`),
+	"HU": regexp.MustCompile(`^\d{8}$`),
+	"IE": regexp.MustCompile(`^(?:\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`),
+	"IT": regexp.MustCompile(`^\d{11}$`),
+	"LT": regexp.MustCompile(`^(?:\d{9}|\d{12})$`),
+	"LU": regexp.MustCompile(`^\d{8}$`),
+	"LV": regexp.MustCompile(`^\d{11}$`),
+	"MT": regexp.MustCompile(`^\d{8}$`),
+	"NL": regexp.MustCompile(`^\d{9}B\d{2}$`),
+	"PL": regexp.MustCompile(`^\d{10}$`),
+	"PT": regexp.MustCompile(`^\d{9}$`),
+	"RO": regexp.MustCompile(`^\d{2,10}$`),
+	"SE": regexp.MustCompile(`^\d{10}01$`),
+	"SI": regexp.MustCompile(`^\d{8}$`),
+	"SK": regexp.MustCompile(`^\d{10}$`),
+	"XI": regexp.MustCompile(`^(?:\d{9}|\d{12}|GD\d{3}|HA\d{3})$`),
+}
+
+// VATOption represents a configuration option for VATNumber
+type VATOption func(*vatValidator)
+
+// WithVIESLookup checks that well-formed VAT numbers are registered, e.g. with the VIES service of the
+// European Commission. The lookup receives the normalized number with its country prefix (e.g. "FR40303265045")
+// and the context of the schema; it supports the options of ExistsIn, like WithLookupCache.
+func WithVIESLookup(lookup LookupFunc[string], opts ...LookupOption[string]) VATOption {
+	return func(v *vatValidator) {
+		v.lookup = newLookupValidator(lookup, true, opts)
+	}
+}
+
+// vatValidator validates the format of VAT numbers and optionally their registration
+type vatValidator struct {
+	lookup *lookupValidator[string]
+	msg    string
+}
+
+// VATNumber validator validates that a string is a VAT number with its country prefix, e.g. "FR40303265045"
+// or "DE 123 456 789", using the format of the country (EU member states, GB and XI).
+// Spaces, dots and hyphens are ignored. Use WithVIESLookup to also check the registration of the number.
+func VATNumber(opts ...VATOption) Validator {
+	v := &vatValidator{}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Validate validates a value using a background context
+func (v *vatValidator) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext validates the format of the VAT number, then its registration when a lookup is set
+func (v *vatValidator) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	if value == nil {
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("vat_number validation requires string value and not a %T type", value)
+	}
+
+	if str == "" {
+		return nil
+	}
+
+	number, ok := normalizeVATNumber(str)
+	if !ok {
+		return v.failure(newValidationError(CodeVATNumber))
+	}
+
+	if v.lookup == nil {
+		return nil
+	}
+
+	results, err := v.lookup.resolve(ctx, []string{number})
+	if err != nil {
+		return err
+	}
+
+	if !results[number] {
+		return v.failure(newValidationError(CodeVATNotRegistered, "value", number))
+	}
+
+	return nil
+}
+
+// failure returns the custom message if set, or the given error
+func (v *vatValidator) failure(err error) error {
+	if v.msg != "" {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// WithMessage sets a custom error message for the validator
+func (v *vatValidator) WithMessage(msg string) Validator {
+	clone := *v
+	clone.msg = msg
+	return &clone
+}
+
+// Describe returns the constraint metadata of the validator
+func (v *vatValidator) Describe() ConstraintInfo {
+	return ConstraintInfo{Kind: "vat_number"}
+}
+
+// performsIO implements ioValidator interface
+func (v *vatValidator) performsIO() bool {
+	return v.lookup != nil
+}
+
+// normalizeVATNumber removes the separators of a VAT number, uppercases it and checks its format
+func normalizeVATNumber(str string) (string, bool) {
+	number := strings.ToUpper(stripSeparators(str, " .-"))
+	if len(number) < 3 {
+		return "", false
+	}
+
+	format, ok := vatFormats[number[:2]]
+	if !ok || !format.MatchString(number[2:]) {
+		return "", false
+	}
+
+	return number, true
+}
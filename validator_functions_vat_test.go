@@ -0,0 +1,71 @@
+package poxxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVATNumber(t *testing.T) {
+	t.Run("formats", func(t *testing.T) {
+		tests := []struct {
+			value   interface{}
+			wantErr string
+		}{
+			{"FR40303265045", ""},
+			{"fr 40 303 265 045", ""},
+			{"DE123456789", ""},
+			{"EL123456789", ""},
+			{"NL123456789B01", ""},
+			{"ATU12345678", ""},
+			{"", ""},
+			{"DE12345678", "invalid VAT number"},
+			{"GR123456789", "invalid VAT number"},
+			{"US123456789", "invalid VAT number"},
+			{"FR", "invalid VAT number"},
+			{123456789, "vat_number validation requires string value and not a int type"},
+		}
+
+		for _, tt := range tests {
+			err := VATNumber().Validate(tt.value, "vat")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr, "%v", tt.value)
+			} else {
+				assert.NoError(t, err, "%v", tt.value)
+			}
+		}
+	})
+
+	t.Run("VIES lookup", func(t *testing.T) {
+		var looked []string
+		registered := func(ctx context.Context, number string) (bool, error) {
+			looked = append(looked, number)
+			return number == "FR40303265045", nil
+		}
+		validator := VATNumber(WithVIESLookup(registered))
+
+		assert.NoError(t, validator.Validate("FR 40 303 265 045", "vat"))
+		assert.EqualError(t, validator.Validate("DE123456789", "vat"), "VAT number DE123456789 is not registered")
+		assert.EqualError(t, validator.Validate("DE1234", "vat"), "invalid VAT number")
+		assert.Equal(t, []string{"FR40303265045", "DE123456789"}, looked)
+
+		assert.True(t, isIOValidator(validator))
+		assert.False(t, isIOValidator(VATNumber()))
+	})
+
+	t.Run("lookup error", func(t *testing.T) {
+		unavailable := func(ctx context.Context, number string) (bool, error) {
+			return false, errors.New("service unavailable")
+		}
+
+		err := VATNumber(WithVIESLookup(unavailable)).Validate("FR40303265045", "vat")
+		assert.EqualError(t, err, "lookup failed: service unavailable")
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		err := VATNumber().WithMessage("numéro de TVA invalide").Validate("FR1", "vat")
+		assert.EqualError(t, err, "numéro de TVA invalide")
+	})
+}
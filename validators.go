@@ -1,8 +1,12 @@
 package poxxy
 
 import (
+	"context"
+	"database/sql/driver"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 // Validator represents a validation function
@@ -13,6 +17,14 @@ type Validator interface {
 	WithMessage(msg string) Validator
 }
 
+// ContextValidator is implemented by validators needing a context (e.g. database or API lookups).
+// Fields call ValidateContext with the context of the schema (see WithContext) instead of Validate.
+type ContextValidator interface {
+	Validator
+	// ValidateContext validates a value and returns an error if validation fails
+	ValidateContext(ctx context.Context, value interface{}, fieldName string) error
+}
+
 // ValidatorFn is a generic function that implements Validator
 type ValidatorFn[T any] struct {
 	fn  func(T, string) error
@@ -29,7 +41,7 @@ func (v ValidatorFn[T]) Validate(value interface{}, fieldName string) error {
 
 	err := v.fn(typedValue, fieldName)
 	if err != nil && v.msg != "" {
-		return fmt.Errorf("%s", v.msg)
+		return withMessage(err, v.msg)
 	}
 
 	return err
@@ -45,23 +57,127 @@ func NewValidatorFn[T any](fn func(T, string) error) ValidatorFn[T] {
 	return ValidatorFn[T]{fn: fn}
 }
 
+// contextValidatorFn is a typed validator function receiving the context of the schema
+type contextValidatorFn[T any] struct {
+	fn  func(context.Context, T, string) error
+	msg string
+}
+
+// Validate validates a value using a background context
+func (v contextValidatorFn[T]) Validate(value interface{}, fieldName string) error {
+	return v.ValidateContext(context.Background(), value, fieldName)
+}
+
+// ValidateContext validates a value using the validator function
+func (v contextValidatorFn[T]) ValidateContext(ctx context.Context, value interface{}, fieldName string) error {
+	typedValue, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("expected type %T, got %T", *new(T), value)
+	}
+
+	err := v.fn(ctx, typedValue, fieldName)
+	if err != nil && v.msg != "" {
+		return withMessage(err, v.msg)
+	}
+
+	return err
+}
+
+// WithMessage sets a custom error message for the validator
+func (v contextValidatorFn[T]) WithMessage(msg string) Validator {
+	return contextValidatorFn[T]{fn: v.fn, msg: msg}
+}
+
+// ValidatorFuncContext creates a typed validator receiving the context given to ApplyContext or WithContext,
+// for validators hitting a database or an external service, or reading request-scoped values (tenant, user)
+func ValidatorFuncContext[T any](fn func(ctx context.Context, value T, fieldName string) error) Validator {
+	return contextValidatorFn[T]{fn: fn}
+}
+
 // NewInterfaceValidator creates a validator that can handle interface{} values
 // This is used for backward compatibility with existing validators
 func NewInterfaceValidator(fn func(interface{}, string) error) Validator {
 	return &interfaceValidator{fn: fn}
 }
 
+// newDescribedValidator creates an interface validator carrying constraint metadata
+func newDescribedValidator(info ConstraintInfo, fn func(interface{}, string) error) Validator {
+	return &interfaceValidator{fn: fn, info: info}
+}
+
+// newStringValidator creates a described validator for string values.
+// driver.Valuer values are resolved first; nil and empty strings are considered valid
+// (use the Required() validator to enforce presence).
+func newStringValidator(info ConstraintInfo, fn func(str string) error) Validator {
+	return newDescribedValidator(info, func(value interface{}, fieldName string) error {
+		if valuer, ok := value.(driver.Valuer); ok {
+			vv, err := valuer.Value()
+			if err != nil {
+				return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+			}
+			value = vv
+		}
+
+		if value == nil {
+			return nil
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s validation requires string value and not a %T type", info.Kind, value)
+		}
+
+		if str == "" {
+			return nil
+		}
+
+		return fn(str)
+	})
+}
+
+// newNumberValidator creates a described validator for numeric values, converted to float64.
+// driver.Valuer values are resolved first; nil is considered valid
+// (use the Required() validator to enforce presence).
+func newNumberValidator(info ConstraintInfo, fn func(n float64) error) Validator {
+	return newDescribedValidator(info, func(value interface{}, fieldName string) error {
+		if valuer, ok := value.(driver.Valuer); ok {
+			vv, err := valuer.Value()
+			if err != nil {
+				return fmt.Errorf("error getting value from driver.Valuer: %w", err)
+			}
+			value = vv
+		}
+
+		if value == nil {
+			return nil
+		}
+
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return fn(float64(v.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return fn(float64(v.Uint()))
+		case reflect.Float32, reflect.Float64:
+			return fn(v.Float())
+		default:
+			return fmt.Errorf("%s validation requires numeric value and not a %T type", info.Kind, value)
+		}
+	})
+}
+
 // interfaceValidator is a special implementation for interface{} type
 type interfaceValidator struct {
-	fn  func(interface{}, string) error
-	msg string
+	fn   func(interface{}, string) error
+	msg  string
+	info ConstraintInfo
 }
 
 // Validate validates a value using the validator function
 func (v *interfaceValidator) Validate(value interface{}, fieldName string) error {
 	err := v.fn(value, fieldName)
 	if err != nil && v.msg != "" {
-		return fmt.Errorf("%s", v.msg)
+		return withMessage(err, v.msg)
 	}
 
 	return err
@@ -69,27 +185,110 @@ func (v *interfaceValidator) Validate(value interface{}, fieldName string) error
 
 // WithMessage sets a custom error message for the validator
 func (v *interfaceValidator) WithMessage(msg string) Validator {
-	return &interfaceValidator{fn: v.fn, msg: msg}
+	return &interfaceValidator{fn: v.fn, msg: msg, info: v.info}
+}
+
+// Describe returns the constraint metadata of the validator
+func (v *interfaceValidator) Describe() ConstraintInfo {
+	return v.info
+}
+
+// validatorErrors holds the failures of the validators of a field when the schema reports them all (see WithAllErrors)
+type validatorErrors []error
+
+// Error returns the failures joined by semicolons
+func (e validatorErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
 }
 
-// validateFieldValidators is a helper function to validate a list of validators, handling RequiredValidator specially
+// validateFieldValidators is a helper function to validate a list of validators, handling RequiredValidator specially.
+// Validators performing I/O run after the others, so they are skipped when a cheap check fails.
+// With WithAllErrors, every validator runs and the failures are returned as validatorErrors.
 func validateFieldValidators(validators []Validator, value interface{}, fieldName string, schema *Schema) error {
+	var deferred []Validator
+	var failures validatorErrors
 	for _, validator := range validators {
-		// Handle RequiredValidator specially - it needs schema context
-		if reqValidator, ok := validator.(RequiredValidator); ok {
-			if err := reqValidator.ValidateWithSchema(schema, fieldName); err != nil {
-				return err
-			}
+		if isIOValidator(validator) {
+			deferred = append(deferred, validator)
+			continue
+		}
+
+		err := runValidator(validator, value, fieldName, schema)
+		if err == nil {
+			continue
+		}
+		if schema == nil || !schema.allErrors {
+			return err
+		}
+
+		// Nested failures (e.g. of When validators) are reported one by one
+		if nested, ok := err.(validatorErrors); ok {
+			failures = append(failures, nested...)
 		} else {
-			if err := validator.Validate(value, fieldName); err != nil {
-				return err
-			}
+			failures = append(failures, err)
+		}
+	}
+
+	if len(failures) == 1 {
+		return failures[0]
+	}
+	if len(failures) > 0 {
+		return failures
+	}
+
+	for _, validator := range deferred {
+		if err := runValidator(validator, value, fieldName, schema); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// runValidator runs a validator with the schema (Required, When) or its context (ContextValidator) when needed
+func runValidator(validator Validator, value interface{}, fieldName string, schema *Schema) error {
+	// Handle RequiredValidator specially - it needs schema context
+	if reqValidator, ok := validator.(RequiredValidator); ok {
+		return reqValidator.ValidateWithSchema(schema, fieldName)
+	}
+
+	if schemaValidator, ok := validator.(schemaValidator); ok {
+		return schemaValidator.validateWithSchema(schema, value, fieldName)
+	}
+
+	if ctxValidator, ok := validator.(ContextValidator); ok {
+		return ctxValidator.ValidateContext(schema.Context(), value, fieldName)
+	}
+
+	return validator.Validate(value, fieldName)
+}
+
+// requiredValidators returns the Required validators of a list, for fields validating nothing else without a value
+func requiredValidators(validators []Validator) []Validator {
+	var required []Validator
+	for _, validator := range validators {
+		if _, ok := validator.(RequiredValidator); ok {
+			required = append(required, validator)
+		}
+		if _, ok := validator.(*requiredWithValidator); ok {
+			required = append(required, validator)
+		}
+		// Conditional Required validators apply without a value too
+		if conditional, ok := validator.(*conditionalValidator); ok {
+			if requiredOnly := conditional.requiredOnly(); requiredOnly != nil {
+				required = append(required, requiredOnly)
+			}
+		}
+	}
+
+	return required
+}
+
 // Option represents a configuration option
 type Option interface {
 	Apply(interface{})
@@ -100,6 +299,11 @@ type ValidatorsAppender interface {
 	AppendValidators(validators []Validator)
 }
 
+// ValidatorsGetter is an interface for fields that expose their validators
+type ValidatorsGetter interface {
+	GetValidators() []Validator
+}
+
 // ValidatorsOption holds validators
 type ValidatorsOption struct {
 	validators []Validator
@@ -175,3 +379,79 @@ func (o DefaultOption[T]) Apply(field interface{}) {
 func WithDefault[T any](defaultValue T) Option {
 	return DefaultOption[T]{defaultValue: defaultValue}
 }
+
+// DefaultFuncSetter is an interface for fields that can compute their default value
+type DefaultFuncSetter[T any] interface {
+	SetDefaultFunc(defaultFunc func(*Schema) (T, error))
+}
+
+// DefaultFuncOption holds a function computing a default value
+type DefaultFuncOption[T any] struct {
+	defaultFunc func(*Schema) (T, error)
+}
+
+// Apply applies the default function to the field
+func (o DefaultFuncOption[T]) Apply(field interface{}) {
+	if setter, ok := field.(DefaultFuncSetter[T]); ok {
+		setter.SetDefaultFunc(o.defaultFunc)
+	} else {
+		panic(fmt.Sprintf("WithDefaultFunc doesn't support %T", field))
+	}
+}
+
+// WithDefaultFunc creates an option computing the default value of a value or pointer field
+// from the other fields of the schema (e.g. a currency derived from the country).
+// Declare the fields it reads with After so they are assigned first.
+func WithDefaultFunc[T any](defaultFunc func(*Schema) (T, error)) Option {
+	return DefaultFuncOption[T]{defaultFunc: defaultFunc}
+}
+
+// StrictOption holds the strict mode of a field
+type StrictOption struct {
+	strict bool
+}
+
+// Apply applies the strict mode to the field
+func (o StrictOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetStrict(bool) }); ok {
+		f.SetStrict(o.strict)
+	} else {
+		panic(fmt.Sprintf("Strict doesn't support %T", field))
+	}
+}
+
+// Strict makes a slice field reject elements that are not of its element type (e.g. "2" or 3.9 in a []int)
+// instead of coercing them. Every invalid element is reported with its index.
+func Strict() Option {
+	return StrictOption{strict: true}
+}
+
+// MaxElementsOption holds the maximum number of elements of a collection field
+type MaxElementsOption struct {
+	n int
+}
+
+// Apply applies the maximum number of elements to the field
+func (o MaxElementsOption) Apply(field interface{}) {
+	if f, ok := field.(interface{ SetMaxElements(int) }); ok {
+		f.SetMaxElements(o.n)
+	} else {
+		panic(fmt.Sprintf("WithMaxElements doesn't support %T", field))
+	}
+}
+
+// WithMaxElements limits the number of elements of a slice or map field.
+// Unlike MaxLength, the limit is enforced during assignment, before any element is converted
+// or any sub-schema is built, so oversized payloads are rejected cheaply.
+func WithMaxElements(n int) Option {
+	return MaxElementsOption{n: n}
+}
+
+// checkMaxElements returns a max_items validation error if a collection is too large
+func checkMaxElements(n, max int) error {
+	if n > max {
+		return newValidationError(CodeMaxItems, "max", strconv.Itoa(max))
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package poxxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// VersionedSchema selects the schema of a request among several versions of an endpoint
+type VersionedSchema struct {
+	schemas        map[string]*Schema
+	header         string
+	field          string
+	defaultVersion string
+}
+
+// VersionedOption represents a configuration option for a VersionedSchema
+type VersionedOption func(*VersionedSchema)
+
+// WithVersionHeader sets the header holding the version, "Accept-Version" by default
+func WithVersionHeader(name string) VersionedOption {
+	return func(v *VersionedSchema) {
+		v.header = name
+	}
+}
+
+// WithVersionField reads the version from the given input field when the header is absent:
+// a query parameter, or a top-level key of a JSON or form body
+func WithVersionField(name string) VersionedOption {
+	return func(v *VersionedSchema) {
+		v.field = name
+	}
+}
+
+// WithDefaultVersion sets the version used when the request doesn't specify one.
+// Without a default version, requests must specify one.
+func WithDefaultVersion(version string) VersionedOption {
+	return func(v *VersionedSchema) {
+		v.defaultVersion = version
+	}
+}
+
+// Versioned creates a VersionedSchema selecting the schema of each request by version:
+//
+//	users := poxxy.Versioned(map[string]*poxxy.Schema{
+//		"1": poxxy.NewSchema(poxxy.Value("name", &v1.Name)),
+//		"2": poxxy.NewSchema(poxxy.Value("first_name", &v2.FirstName), poxxy.Value("last_name", &v2.LastName)),
+//	}, poxxy.WithDefaultVersion("1"))
+//
+//	version, err := users.ApplyHTTPRequest(w, r, nil)
+func Versioned(schemas map[string]*Schema, opts ...VersionedOption) *VersionedSchema {
+	versioned := &VersionedSchema{
+		schemas: schemas,
+		header:  "Accept-Version",
+	}
+
+	for _, opt := range opts {
+		opt(versioned)
+	}
+
+	return versioned
+}
+
+// Versions returns the supported versions, sorted
+func (v *VersionedSchema) Versions() []string {
+	versions := make([]string, 0, len(v.schemas))
+	for version := range v.schemas {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return versions
+}
+
+// Schema returns the schema of a version, or of the default version when version is empty
+func (v *VersionedSchema) Schema(version string) (*Schema, error) {
+	if version == "" {
+		version = v.defaultVersion
+	}
+
+	if version == "" {
+		return nil, fmt.Errorf("missing version, supported versions are %s", strings.Join(v.Versions(), ", "))
+	}
+
+	schema, ok := v.schemas[version]
+	if !ok {
+		return nil, fmt.Errorf("unsupported version %q, supported versions are %s", version, strings.Join(v.Versions(), ", "))
+	}
+
+	return schema, nil
+}
+
+// Apply applies data to the schema of the version found in the version field (see WithVersionField),
+// or of the default version. It returns the selected version.
+func (v *VersionedSchema) Apply(data map[string]interface{}, options ...SchemaOption) (string, error) {
+	var version string
+	if v.field != "" {
+		version = versionString(data[v.field])
+	}
+
+	options = v.schemaOptions(options)
+	return v.apply(version, func(schema *Schema) error {
+		return schema.Apply(data, options...)
+	})
+}
+
+// ApplyHTTPRequest applies an HTTP request to the schema of the version found in the version header,
+// then in the version field (see WithVersionField), falling back to the default version.
+// It returns the selected version so the handler knows which bound variables were filled.
+// Compressed bodies are not inspected for the version field.
+func (v *VersionedSchema) ApplyHTTPRequest(w http.ResponseWriter, r *http.Request, httpRequestOption *HTTPRequestOption, options ...SchemaOption) (string, error) {
+	version := strings.TrimSpace(r.Header.Get(v.header))
+	if version == "" && v.field != "" {
+		version = r.URL.Query().Get(v.field)
+	}
+	if version == "" && v.field != "" {
+		bodyVersion, err := v.bodyVersion(w, r, httpRequestOption)
+		if err != nil {
+			return "", err
+		}
+		version = bodyVersion
+	}
+
+	options = v.schemaOptions(options)
+	return v.apply(version, func(schema *Schema) error {
+		return schema.ApplyHTTPRequest(w, r, httpRequestOption, options...)
+	})
+}
+
+// schemaOptions returns the options given to the schema of a version: the version field is read by the
+// VersionedSchema, so it isn't an unknown field of the schema
+func (v *VersionedSchema) schemaOptions(options []SchemaOption) []SchemaOption {
+	if v.field == "" {
+		return options
+	}
+
+	return append([]SchemaOption{withExtraKeys(v.field)}, options...)
+}
+
+// apply selects the schema of a version and applies it
+func (v *VersionedSchema) apply(version string, apply func(*Schema) error) (string, error) {
+	schema, err := v.Schema(version)
+	if err != nil {
+		return version, err
+	}
+
+	if version == "" {
+		version = v.defaultVersion
+	}
+
+	return version, apply(schema)
+}
+
+// bodyVersion reads the version field of a JSON or form body. The body is buffered
+// and restored so the selected schema can read it.
+func (v *VersionedSchema) bodyVersion(w http.ResponseWriter, r *http.Request, httpRequestOption *HTTPRequestOption) (string, error) {
+	contentType := r.Header.Get("Content-Type")
+	if r.Body == nil || r.Header.Get("Content-Encoding") != "" ||
+		(contentType != "application/json" && contentType != "application/x-www-form-urlencoded") {
+		return "", nil
+	}
+
+	maxSize := MaxBodySize
+	if httpRequestOption != nil && httpRequestOption.MaxRequestBodySize > 0 {
+		maxSize = httpRequestOption.MaxRequestBodySize
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if contentType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", nil // Reported by the schema
+		}
+		return values.Get(v.field), nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return "", nil // Reported by the schema
+	}
+
+	return versionString(data[v.field]), nil
+}
+
+// versionString returns the version held by an input value ("2" or 2)
+func versionString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(fmt.Sprint(value))
+}
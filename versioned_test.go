@@ -0,0 +1,123 @@
+package poxxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersioned(t *testing.T) {
+	var name, firstName, lastName string
+
+	newVersioned := func(opts ...VersionedOption) *VersionedSchema {
+		name, firstName, lastName = "", "", ""
+		return Versioned(map[string]*Schema{
+			"1": NewSchema(Value("name", &name, WithValidators(Required()))),
+			"2": NewSchema(
+				Value("first_name", &firstName, WithValidators(Required())),
+				Value("last_name", &lastName),
+			),
+		}, opts...)
+	}
+
+	newRequest := func(contentType, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		return r
+	}
+
+	t.Run("header", func(t *testing.T) {
+		versioned := newVersioned()
+		r := newRequest("application/json", `{"first_name": "John", "last_name": "Doe"}`)
+		r.Header.Set("Accept-Version", "2")
+
+		version, err := versioned.ApplyHTTPRequest(nil, r, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+		assert.Equal(t, "John", firstName)
+		assert.Equal(t, "Doe", lastName)
+	})
+
+	t.Run("custom header", func(t *testing.T) {
+		versioned := newVersioned(WithVersionHeader("X-API-Version"))
+		r := newRequest("application/json", `{"name": "John"}`)
+		r.Header.Set("X-API-Version", "1")
+
+		version, err := versioned.ApplyHTTPRequest(nil, r, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, "John", name)
+	})
+
+	t.Run("default version", func(t *testing.T) {
+		versioned := newVersioned(WithDefaultVersion("1"))
+
+		version, err := versioned.ApplyHTTPRequest(nil, newRequest("application/json", `{"name": "John"}`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, "John", name)
+	})
+
+	t.Run("version field in JSON body", func(t *testing.T) {
+		versioned := newVersioned(WithVersionField("version"), WithDefaultVersion("1"))
+
+		version, err := versioned.ApplyHTTPRequest(nil, newRequest("application/json", `{"version": 2, "first_name": "John"}`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+		assert.Equal(t, "John", firstName)
+	})
+
+	t.Run("version field in form body and query", func(t *testing.T) {
+		versioned := newVersioned(WithVersionField("version"))
+
+		version, err := versioned.ApplyHTTPRequest(nil, newRequest("application/x-www-form-urlencoded", "version=2&first_name=John"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+		assert.Equal(t, "John", firstName)
+
+		r := httptest.NewRequest(http.MethodGet, "/users?version=1&name=Jane", nil)
+		version, err = versioned.ApplyHTTPRequest(nil, r, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, "Jane", name)
+	})
+
+	t.Run("missing and unsupported versions", func(t *testing.T) {
+		versioned := newVersioned()
+
+		_, err := versioned.ApplyHTTPRequest(nil, newRequest("application/json", `{"name": "John"}`), nil)
+		assert.EqualError(t, err, "missing version, supported versions are 1, 2")
+
+		r := newRequest("application/json", `{"name": "John"}`)
+		r.Header.Set("Accept-Version", "3")
+		version, err := versioned.ApplyHTTPRequest(nil, r, nil)
+		assert.Equal(t, "3", version)
+		assert.EqualError(t, err, `unsupported version "3", supported versions are 1, 2`)
+	})
+
+	t.Run("validation errors of the selected schema", func(t *testing.T) {
+		versioned := newVersioned(WithVersionField("version"))
+
+		version, err := versioned.Apply(map[string]interface{}{"version": "2", "name": "John"})
+		assert.Equal(t, "2", version)
+		var errs Errors
+		require.ErrorAs(t, err, &errs)
+		assert.Equal(t, "first_name", errs[0].Field)
+	})
+	t.Run("version field with unknown fields disallowed", func(t *testing.T) {
+		versioned := newVersioned(WithVersionField("version"))
+
+		version, err := versioned.Apply(map[string]interface{}{"version": "1", "name": "John"}, WithDisallowUnknownFields())
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, "John", name)
+
+		version, err = versioned.ApplyHTTPRequest(nil, newRequest("application/json", `{"version": 2, "first_name": "John", "age": 30}`), nil, WithDisallowUnknownFields())
+		assert.Equal(t, "2", version)
+		assert.EqualError(t, err, "age: unknown field")
+	})
+}